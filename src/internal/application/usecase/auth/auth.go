@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// カスタムエラー用の構造体を定義
+type ErrTokenMalformed struct{}
+
+func (e *ErrTokenMalformed) Error() string {
+	return "認証用トークンの形式が不正です。"
+}
+
+type ErrTokenExpired struct{}
+
+func (e *ErrTokenExpired) Error() string {
+	return "認証用トークンの有効期限が切れています。"
+}
+
+type ErrTokenInvalid struct{}
+
+func (e *ErrTokenInvalid) Error() string {
+	return "認証用トークンが無効です。"
+}
+
+// 検証済みトークンのクレーム
+type Claims struct {
+	Subject   string
+	Issuer    string
+	Audience  []string
+	ExpiresAt time.Time
+}
+
+type TokenVerifier interface {
+	// 署名とクレーム（exp/nbf/iss/aud）を検証し、検証済みのクレームを返す。
+	// エラーはErrTokenMalformed、ErrTokenExpired、ErrTokenInvalidのいずれかを返す。
+	Verify(ctx context.Context, token string) (*Claims, error)
+}
@@ -0,0 +1,90 @@
+package authorization
+
+import (
+	"context"
+
+	"go-gin-domain/internal/application/usecase/logger"
+	"go-gin-domain/internal/domain/apperror"
+	"go-gin-domain/internal/domain/repository"
+	domain_user "go-gin-domain/internal/domain/user"
+)
+
+// カスタムエラー用の構造体を定義
+type ErrPermissionDenied struct{}
+
+func (e *ErrPermissionDenied) Error() string {
+	return "この操作は許可されていません。"
+}
+
+type ErrActorNotFound struct{}
+
+func (e *ErrActorNotFound) Error() string {
+	return "操作するユーザーが登録されていません。"
+}
+
+// 操作するユーザー（認証済みのユーザー）
+type Actor struct {
+	UID  string
+	Role domain_user.Role
+}
+
+type Authorizer interface {
+	// uidのユーザーを操作者として取得する（存在しない場合はErrActorNotFound（ErrForbiddenでラップ）を返す）
+	FindActor(ctx context.Context, uid string) (Actor, error)
+	// 操作を許可するか判定する（許可しない場合はErrPermissionDenied（ErrForbiddenでラップ）を返す）
+	// ownerUIDは操作対象の所有者のUID（ユーザーの場合は対象ユーザー、Postの場合は投稿者）。所有者が無い操作の場合は空にする。
+	Authorize(ctx context.Context, actor Actor, permission Permission, ownerUID string) error
+}
+
+type authorizer struct {
+	db       repository.DB
+	userRepo domain_user.UserRepository
+	policy   Policy
+	logger   logger.Logger
+}
+
+func NewAuthorizer(db repository.DB, userRepo domain_user.UserRepository, policy Policy, logger logger.Logger) Authorizer {
+	return &authorizer{
+		db:       db,
+		userRepo: userRepo,
+		policy:   policy,
+		logger:   logger,
+	}
+}
+
+func (a *authorizer) FindActor(ctx context.Context, uid string) (Actor, error) {
+	user, err := a.userRepo.FindByUID(ctx, a.db, uid)
+	if err != nil {
+		return Actor{}, err
+	}
+
+	// トークンは有効でもユーザーが未登録または削除済みの場合はエラー
+	if user == nil {
+		a.logger.Warn(ctx, (&ErrActorNotFound{}).Error(), "actorUid", uid)
+		return Actor{}, &apperror.ErrForbidden{Err: &ErrActorNotFound{}}
+	}
+
+	return Actor{UID: user.UID, Role: user.Role}, nil
+}
+
+func (a *authorizer) Authorize(ctx context.Context, actor Actor, permission Permission, ownerUID string) error {
+	if !a.policy.Allows(actor, permission, ownerUID) {
+		a.logger.Warn(ctx, "操作を拒否しました。", "actorUid", actor.UID, "role", actor.Role, "permission", permission, "ownerUid", ownerUID)
+		return &apperror.ErrForbidden{Err: &ErrPermissionDenied{}}
+	}
+	return nil
+}
+
+// 共通コンテキストのキー
+type contextKey struct{}
+
+// 操作するユーザーを共通コンテキストに設定する（認可用のミドルウェアで設定）
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, contextKey{}, actor)
+}
+
+// 共通コンテキストから操作するユーザーを取得する
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(contextKey{}).(Actor)
+	return actor, ok
+}
@@ -0,0 +1,127 @@
+//go:build unit
+
+package authorization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	mockLogger "go-gin-domain/internal/application/usecase/logger/mock_logger"
+	"go-gin-domain/internal/domain/apperror"
+	domain_user "go-gin-domain/internal/domain/user"
+	mockUser "go-gin-domain/internal/domain/user/mock_user_repository"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPolicy_Allows(t *testing.T) {
+	policy := DefaultPolicy()
+	admin := Actor{UID: "xxxx-xxxx-xxxx-0001", Role: domain_user.RoleAdmin}
+	member := Actor{UID: "xxxx-xxxx-xxxx-0002", Role: domain_user.RoleMember}
+
+	tests := []struct {
+		name       string
+		actor      Actor
+		permission Permission
+		ownerUID   string
+		want       bool
+	}{
+		{"adminはユーザー一覧を取得できること", admin, PermissionListUsers, "", true},
+		{"adminは他のユーザーを更新できること", admin, PermissionUpdateUser, member.UID, true},
+		{"adminは他のユーザーのPostを削除できること", admin, PermissionDeletePost, member.UID, true},
+		{"memberはユーザー一覧を取得できないこと", member, PermissionListUsers, "", false},
+		{"memberは自分自身を取得できること", member, PermissionReadUser, member.UID, true},
+		{"memberは他のユーザーを取得できないこと", member, PermissionReadUser, admin.UID, false},
+		{"memberは他のユーザーを削除できないこと", member, PermissionDeleteUser, admin.UID, false},
+		{"memberはPostを作成できること", member, PermissionCreatePost, "", true},
+		{"memberは自分のPostを更新できること", member, PermissionUpdatePost, member.UID, true},
+		{"memberは他のユーザーのPostを更新できないこと", member, PermissionUpdatePost, admin.UID, false},
+		{"memberは投稿者が不明なPostを削除できないこと", member, PermissionDeletePost, "", false},
+		{"役割が不明な場合は許可しないこと", Actor{UID: member.UID, Role: "guest"}, PermissionReadUser, member.UID, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, policy.Allows(tt.actor, tt.permission, tt.ownerUID))
+		})
+	}
+}
+
+func TestAuthorizer_FindActor(t *testing.T) {
+	// DBのモック
+	mockDB := &sql.DB{}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mockUser.NewMockUserRepository(ctrl)
+	mockLogger := mockLogger.NewMockLogger(ctrl)
+
+	authorizer := NewAuthorizer(mockDB, mockRepo, DefaultPolicy(), mockLogger)
+
+	t.Run("ユーザーの役割を取得できること", func(t *testing.T) {
+		mockRepo.EXPECT().FindByUID(gomock.Any(), mockDB, "xxxx-xxxx-xxxx-0001").Return(&domain_user.User{UID: "xxxx-xxxx-xxxx-0001", Role: domain_user.RoleAdmin}, nil)
+
+		// 処理実行
+		actor, err := authorizer.FindActor(context.Background(), "xxxx-xxxx-xxxx-0001")
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, Actor{UID: "xxxx-xxxx-xxxx-0001", Role: domain_user.RoleAdmin}, actor)
+	})
+
+	t.Run("ユーザーが存在しない場合はErrActorNotFoundを返すこと", func(t *testing.T) {
+		mockRepo.EXPECT().FindByUID(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockLogger.EXPECT().Warn(gomock.Any(), "操作するユーザーが登録されていません。", "actorUid", "xxxx-xxxx-xxxx-0009")
+
+		// 処理実行
+		_, err := authorizer.FindActor(context.Background(), "xxxx-xxxx-xxxx-0009")
+
+		// 検証
+		var errForbidden *apperror.ErrForbidden
+		var errActorNotFound *ErrActorNotFound
+		assert.ErrorAs(t, err, &errForbidden)
+		assert.ErrorAs(t, err, &errActorNotFound)
+	})
+
+	t.Run("リポジトリのエラーをそのまま返すこと", func(t *testing.T) {
+		repoErr := errors.New("connection refused")
+		mockRepo.EXPECT().FindByUID(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, repoErr)
+
+		// 処理実行
+		_, err := authorizer.FindActor(context.Background(), "xxxx-xxxx-xxxx-0001")
+
+		// 検証
+		assert.ErrorIs(t, err, repoErr)
+	})
+}
+
+func TestAuthorizer_Authorize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := mockLogger.NewMockLogger(ctrl)
+
+	authorizer := NewAuthorizer(nil, nil, DefaultPolicy(), mockLogger)
+	member := Actor{UID: "xxxx-xxxx-xxxx-0002", Role: domain_user.RoleMember}
+
+	t.Run("許可された操作の場合はエラーを返さないこと", func(t *testing.T) {
+		err := authorizer.Authorize(context.Background(), member, PermissionUpdateUser, member.UID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("許可されない操作の場合はErrPermissionDeniedを返すこと", func(t *testing.T) {
+		mockLogger.EXPECT().Warn(gomock.Any(), "操作を拒否しました。",
+			"actorUid", member.UID, "role", domain_user.RoleMember, "permission", PermissionUpdateUser, "ownerUid", "xxxx-xxxx-xxxx-0001")
+
+		// 処理実行
+		err := authorizer.Authorize(context.Background(), member, PermissionUpdateUser, "xxxx-xxxx-xxxx-0001")
+
+		// 検証
+		var errForbidden *apperror.ErrForbidden
+		var errPermissionDenied *ErrPermissionDenied
+		assert.ErrorAs(t, err, &errForbidden)
+		assert.ErrorAs(t, err, &errPermissionDenied)
+	})
+}
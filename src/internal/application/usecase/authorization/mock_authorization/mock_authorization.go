@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/application/usecase/authorization/authorization.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/application/usecase/authorization/authorization.go -destination=./internal/application/usecase/authorization/mock_authorization/mock_authorization.go
+//
+
+// Package mock_authorization is a generated GoMock package.
+package mock_authorization
+
+import (
+	context "context"
+	authorization "go-gin-domain/internal/application/usecase/authorization"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthorizer is a mock of Authorizer interface.
+type MockAuthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizerMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthorizerMockRecorder is the mock recorder for MockAuthorizer.
+type MockAuthorizerMockRecorder struct {
+	mock *MockAuthorizer
+}
+
+// NewMockAuthorizer creates a new mock instance.
+func NewMockAuthorizer(ctrl *gomock.Controller) *MockAuthorizer {
+	mock := &MockAuthorizer{ctrl: ctrl}
+	mock.recorder = &MockAuthorizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizer) EXPECT() *MockAuthorizerMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockAuthorizer) Authorize(ctx context.Context, actor authorization.Actor, permission authorization.Permission, ownerUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, actor, permission, ownerUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockAuthorizerMockRecorder) Authorize(ctx, actor, permission, ownerUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthorizer)(nil).Authorize), ctx, actor, permission, ownerUID)
+}
+
+// FindActor mocks base method.
+func (m *MockAuthorizer) FindActor(ctx context.Context, uid string) (authorization.Actor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActor", ctx, uid)
+	ret0, _ := ret[0].(authorization.Actor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActor indicates an expected call of FindActor.
+func (mr *MockAuthorizerMockRecorder) FindActor(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActor", reflect.TypeOf((*MockAuthorizer)(nil).FindActor), ctx, uid)
+}
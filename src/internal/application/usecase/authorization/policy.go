@@ -0,0 +1,66 @@
+package authorization
+
+import (
+	domain_user "go-gin-domain/internal/domain/user"
+)
+
+// 操作の権限
+type Permission string
+
+const (
+	PermissionListUsers  Permission = "users:list"
+	PermissionReadUser   Permission = "user:read"
+	PermissionUpdateUser Permission = "user:update"
+	PermissionDeleteUser Permission = "user:delete"
+	PermissionCreatePost Permission = "post:create"
+	PermissionUpdatePost Permission = "post:update"
+	PermissionDeletePost Permission = "post:delete"
+)
+
+// 権限の範囲
+type Scope int
+
+const (
+	// 自分が所有する対象のみ
+	ScopeOwn Scope = iota + 1
+	// 全ての対象
+	ScopeAny
+)
+
+// 役割ごとに許可する権限と範囲
+type Policy map[domain_user.Role]map[Permission]Scope
+
+// デフォルトの権限（adminは全てのユーザーとPostを管理でき、memberは自分自身と自分のPostのみ操作できる）
+func DefaultPolicy() Policy {
+	return Policy{
+		domain_user.RoleAdmin: {
+			PermissionListUsers:  ScopeAny,
+			PermissionReadUser:   ScopeAny,
+			PermissionUpdateUser: ScopeAny,
+			PermissionDeleteUser: ScopeAny,
+			PermissionCreatePost: ScopeAny,
+			PermissionUpdatePost: ScopeAny,
+			PermissionDeletePost: ScopeAny,
+		},
+		domain_user.RoleMember: {
+			PermissionReadUser:   ScopeOwn,
+			PermissionUpdateUser: ScopeOwn,
+			PermissionDeleteUser: ScopeOwn,
+			PermissionCreatePost: ScopeAny,
+			PermissionUpdatePost: ScopeOwn,
+			PermissionDeletePost: ScopeOwn,
+		},
+	}
+}
+
+// 操作を許可するか（範囲が自分のみの場合、所有者が不明な対象は許可しない）
+func (p Policy) Allows(actor Actor, permission Permission, ownerUID string) bool {
+	switch p[actor.Role][permission] {
+	case ScopeAny:
+		return true
+	case ScopeOwn:
+		return ownerUID != "" && ownerUID == actor.UID
+	default:
+		return false
+	}
+}
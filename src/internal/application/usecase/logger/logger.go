@@ -4,8 +4,12 @@ import (
 	"context"
 )
 
+// argsには属性をキーと値の組（例："uid", uid）で指定する
 type Logger interface {
-	Info(ctx context.Context, message string)
-	Warn(ctx context.Context, message string)
-	Error(ctx context.Context, message string)
+	Debug(ctx context.Context, message string, args ...any)
+	Info(ctx context.Context, message string, args ...any)
+	Warn(ctx context.Context, message string, args ...any)
+	Error(ctx context.Context, message string, args ...any)
+	// 全てのログに属性を付与する子ロガーを作成する
+	With(args ...any) Logger
 }
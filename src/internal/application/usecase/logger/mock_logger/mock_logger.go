@@ -11,6 +11,7 @@ package mock_logger
 
 import (
 	context "context"
+	logger "go-gin-domain/internal/application/usecase/logger"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -40,38 +41,88 @@ func (m *MockLogger) EXPECT() *MockLoggerMockRecorder {
 	return m.recorder
 }
 
+// Debug mocks base method.
+func (m *MockLogger) Debug(ctx context.Context, message string, args ...any) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, message}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Debug", varargs...)
+}
+
+// Debug indicates an expected call of Debug.
+func (mr *MockLoggerMockRecorder) Debug(ctx, message any, args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, message}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Debug", reflect.TypeOf((*MockLogger)(nil).Debug), varargs...)
+}
+
 // Error mocks base method.
-func (m *MockLogger) Error(ctx context.Context, message string) {
+func (m *MockLogger) Error(ctx context.Context, message string, args ...any) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Error", ctx, message)
+	varargs := []any{ctx, message}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Error", varargs...)
 }
 
 // Error indicates an expected call of Error.
-func (mr *MockLoggerMockRecorder) Error(ctx, message any) *gomock.Call {
+func (mr *MockLoggerMockRecorder) Error(ctx, message any, args ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockLogger)(nil).Error), ctx, message)
+	varargs := append([]any{ctx, message}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockLogger)(nil).Error), varargs...)
 }
 
 // Info mocks base method.
-func (m *MockLogger) Info(ctx context.Context, message string) {
+func (m *MockLogger) Info(ctx context.Context, message string, args ...any) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Info", ctx, message)
+	varargs := []any{ctx, message}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Info", varargs...)
 }
 
 // Info indicates an expected call of Info.
-func (mr *MockLoggerMockRecorder) Info(ctx, message any) *gomock.Call {
+func (mr *MockLoggerMockRecorder) Info(ctx, message any, args ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Info", reflect.TypeOf((*MockLogger)(nil).Info), ctx, message)
+	varargs := append([]any{ctx, message}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Info", reflect.TypeOf((*MockLogger)(nil).Info), varargs...)
 }
 
 // Warn mocks base method.
-func (m *MockLogger) Warn(ctx context.Context, message string) {
+func (m *MockLogger) Warn(ctx context.Context, message string, args ...any) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Warn", ctx, message)
+	varargs := []any{ctx, message}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Warn", varargs...)
 }
 
 // Warn indicates an expected call of Warn.
-func (mr *MockLoggerMockRecorder) Warn(ctx, message any) *gomock.Call {
+func (mr *MockLoggerMockRecorder) Warn(ctx, message any, args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, message}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Warn", reflect.TypeOf((*MockLogger)(nil).Warn), varargs...)
+}
+
+// With mocks base method.
+func (m *MockLogger) With(args ...any) logger.Logger {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "With", varargs...)
+	ret0, _ := ret[0].(logger.Logger)
+	return ret0
+}
+
+// With indicates an expected call of With.
+func (mr *MockLoggerMockRecorder) With(args ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Warn", reflect.TypeOf((*MockLogger)(nil).Warn), ctx, message)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "With", reflect.TypeOf((*MockLogger)(nil).With), args...)
 }
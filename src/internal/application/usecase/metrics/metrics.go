@@ -0,0 +1,7 @@
+package metrics
+
+// ユースケースの呼び出し回数などのメトリクスを記録する
+type Recorder interface {
+	// 呼び出し結果を記録する（errがnilの場合は成功）
+	ObserveUsecase(usecase, method string, err error)
+}
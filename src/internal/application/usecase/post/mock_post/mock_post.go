@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/application/usecase/post/post.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/application/usecase/post/post.go -destination=./internal/application/usecase/post/mock_post/mock_post.go
+//
+
+// Package mock_post is a generated GoMock package.
+package mock_post
+
+import (
+	context "context"
+	authorization "go-gin-domain/internal/application/usecase/authorization"
+	post "go-gin-domain/internal/domain/post"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPostUsecase is a mock of PostUsecase interface.
+type MockPostUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPostUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPostUsecaseMockRecorder is the mock recorder for MockPostUsecase.
+type MockPostUsecaseMockRecorder struct {
+	mock *MockPostUsecase
+}
+
+// NewMockPostUsecase creates a new mock instance.
+func NewMockPostUsecase(ctrl *gomock.Controller) *MockPostUsecase {
+	mock := &MockPostUsecase{ctrl: ctrl}
+	mock.recorder = &MockPostUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPostUsecase) EXPECT() *MockPostUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPostUsecase) Create(ctx context.Context, authorUID, text string) (*post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, authorUID, text)
+	ret0, _ := ret[0].(*post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPostUsecaseMockRecorder) Create(ctx, authorUID, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPostUsecase)(nil).Create), ctx, authorUID, text)
+}
+
+// Delete mocks base method.
+func (m *MockPostUsecase) Delete(ctx context.Context, actor authorization.Actor, id int64) (*post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, actor, id)
+	ret0, _ := ret[0].(*post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPostUsecaseMockRecorder) Delete(ctx, actor, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPostUsecase)(nil).Delete), ctx, actor, id)
+}
+
+// FindAll mocks base method.
+func (m *MockPostUsecase) FindAll(ctx context.Context, params post.FindAllParams) (*post.FindAllResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, params)
+	ret0, _ := ret[0].(*post.FindAllResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPostUsecaseMockRecorder) FindAll(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPostUsecase)(nil).FindAll), ctx, params)
+}
+
+// FindByID mocks base method.
+func (m *MockPostUsecase) FindByID(ctx context.Context, id int64) (*post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPostUsecaseMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPostUsecase)(nil).FindByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockPostUsecase) Update(ctx context.Context, actor authorization.Actor, id int64, text string) (*post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, actor, id, text)
+	ret0, _ := ret[0].(*post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPostUsecaseMockRecorder) Update(ctx, actor, id, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPostUsecase)(nil).Update), ctx, actor, id, text)
+}
@@ -3,25 +3,47 @@ package post
 import (
 	"context"
 
+	"go-gin-domain/internal/application/usecase/authorization"
 	"go-gin-domain/internal/application/usecase/logger"
+	"go-gin-domain/internal/application/usecase/transaction"
 	domain_post "go-gin-domain/internal/domain/post"
+	"go-gin-domain/internal/domain/repository"
 )
 
+// カスタムエラー用の構造体を定義
+type ErrPostNotFound struct{}
+
+func (e *ErrPostNotFound) Error() string {
+	return "対象のPostが存在しません。"
+}
+
 type PostUsecase interface {
-	Create(ctx context.Context, text string) (*domain_post.Post, error)
-	FindAll(ctx context.Context) ([]*domain_post.Post, error)
+	// authorUIDは認証済みのユーザーのUID
+	Create(ctx context.Context, authorUID, text string) (*domain_post.Post, error)
+	FindAll(ctx context.Context, params domain_post.FindAllParams) (*domain_post.FindAllResult, error)
+	FindByID(ctx context.Context, id int64) (*domain_post.Post, error)
+	// actorは操作するユーザー（memberは投稿者の場合のみ、adminは全てのPostを更新および削除が可能）
+	Update(ctx context.Context, actor authorization.Actor, id int64, text string) (*domain_post.Post, error)
+	Delete(ctx context.Context, actor authorization.Actor, id int64) (*domain_post.Post, error)
 }
 
 type postUsecase struct {
-	db       string
-	postRepo domain_post.PostRepository
-	logger   logger.Logger
+	db        repository.DB
+	txManager transaction.TxManager
+	postRepo  domain_post.PostRepository
+	// 本文のチェック条件
+	textRule   domain_post.TextRule
+	authorizer authorization.Authorizer
+	logger     logger.Logger
 }
 
-func NewPostUsecase(db string, postRepo domain_post.PostRepository, logger logger.Logger) PostUsecase {
+func NewPostUsecase(db repository.DB, txManager transaction.TxManager, postRepo domain_post.PostRepository, textRule domain_post.TextRule, authorizer authorization.Authorizer, logger logger.Logger) PostUsecase {
 	return &postUsecase{
-		db:       db,
-		postRepo: postRepo,
-		logger:   logger,
+		db:         db,
+		txManager:  txManager,
+		postRepo:   postRepo,
+		textRule:   textRule,
+		authorizer: authorizer,
+		logger:     logger,
 	}
 }
@@ -2,17 +2,24 @@ package post
 
 import (
 	"context"
-	"fmt"
 
+	"go-gin-domain/internal/domain/apperror"
 	domain_post "go-gin-domain/internal/domain/post"
 )
 
-func (u *postUsecase) Create(ctx context.Context, text string) (*domain_post.Post, error) {
-	// Postエンティティを新規作成
-	post, err := domain_post.NewPost(text)
+func (u *postUsecase) Create(ctx context.Context, authorUID, text string) (*domain_post.Post, error) {
+	// 値オブジェクトを利用してtextをチェック
+	newText, err := u.textRule.NewText(text)
 	if err != nil {
-		err := fmt.Errorf("バリデーションエラー: %w", err)
-		u.logger.Warn(ctx, err.Error())
+		err := apperror.NewErrValidation("text", err)
+		u.logger.Warn(ctx, "Postの入力値が不正です。", "error", err)
+		return nil, err
+	}
+
+	// Postエンティティを新規作成（認証済みのユーザーを投稿者とする）
+	post, err := domain_post.NewPost(authorUID, newText)
+	if err != nil {
+		u.logger.Error(ctx, "Postの作成に失敗しました。", "authorUid", authorUID, "error", err)
 		return nil, err
 	}
 
@@ -0,0 +1,43 @@
+package post
+
+import (
+	"context"
+
+	"go-gin-domain/internal/application/usecase/authorization"
+	"go-gin-domain/internal/domain/apperror"
+	domain_post "go-gin-domain/internal/domain/post"
+	"go-gin-domain/internal/domain/repository"
+)
+
+func (u *postUsecase) Delete(ctx context.Context, actor authorization.Actor, id int64) (*domain_post.Post, error) {
+	var deletePost *domain_post.Post
+
+	// 取得から論理削除までをトランザクション内で実行
+	err := u.txManager.Do(ctx, func(ctx context.Context, tx repository.DB) error {
+		post, err := u.postRepo.FindByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		// 対象のPostが存在しない場合はエラー
+		if post == nil {
+			return &apperror.ErrNotFound{Err: &ErrPostNotFound{}}
+		}
+
+		// 投稿者またはadmin以外の場合はエラー
+		if err := u.authorizer.Authorize(ctx, actor, authorization.PermissionDeletePost, post.AuthorUID()); err != nil {
+			return err
+		}
+
+		// 論理削除
+		post.Delete()
+
+		deletePost, err = u.postRepo.Save(ctx, tx, post)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletePost, nil
+}
@@ -6,6 +6,12 @@ import (
 	domain_post "go-gin-domain/internal/domain/post"
 )
 
-func (u *postUsecase) FindAll(ctx context.Context) ([]*domain_post.Post, error) {
-	return u.postRepo.FindAll(ctx, u.db)
+func (u *postUsecase) FindAll(ctx context.Context, params domain_post.FindAllParams) (*domain_post.FindAllResult, error) {
+	if err := params.Validate(); err != nil {
+		u.logger.Warn(ctx, "Postの一覧取得の条件が不正です。", "error", err)
+		return nil, err
+	}
+
+	// 未指定の項目にデフォルト値を設定
+	return u.postRepo.FindAll(ctx, u.db, params.Normalize())
 }
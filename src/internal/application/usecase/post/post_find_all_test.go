@@ -0,0 +1,143 @@
+//go:build unit
+
+package post
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	mockAuthorization "go-gin-domain/internal/application/usecase/authorization/mock_authorization"
+	mockLogger "go-gin-domain/internal/application/usecase/logger/mock_logger"
+	mockTransaction "go-gin-domain/internal/application/usecase/transaction/mock_transaction"
+	"go-gin-domain/internal/domain/apperror"
+	domain_post "go-gin-domain/internal/domain/post"
+	mockPost "go-gin-domain/internal/domain/post/mock_post_repository"
+
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 初期処理
+func init() {
+	// テスト用の環境変数ファイル「.env.testing」を読み込んで使用する。
+	if err := godotenv.Load("../../../../.env.testing"); err != nil {
+		fmt.Println(".env.testingの読み込みに失敗しました。")
+	}
+}
+
+func TestPostUsecase_FindAll(t *testing.T) {
+	// DBのモック
+	mockDB := &sql.DB{}
+
+	// リポジトリのモック
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mockPost.NewMockPostRepository(ctrl)
+
+	// ロガーのモック
+	mockLogger := mockLogger.NewMockLogger(ctrl)
+
+	// トランザクション管理のモック
+	mockTxManager := mockTransaction.NewMockTxManager(ctrl)
+
+	// 認可のモック
+	mockAuthorizer := mockAuthorization.NewMockAuthorizer(ctrl)
+
+	createdAfter := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	createdBefore := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常終了すること", func(t *testing.T) {
+		// モック化
+		expectedPosts := []*domain_post.Post{
+			domain_post.ReconstitutePost(1, "こんにちは", "xxxx-xxxx-xxxx-0001", createdAfter, createdAfter, nil),
+			domain_post.ReconstitutePost(2, "こんばんは", "xxxx-xxxx-xxxx-0001", createdAfter, createdAfter, nil),
+		}
+		expectedParams := domain_post.FindAllParams{
+			Limit:         domain_post.DefaultLimit,
+			AuthorUID:     "xxxx-xxxx-xxxx-0001",
+			CreatedAfter:  createdAfter,
+			CreatedBefore: createdBefore,
+		}
+		mockRepo.EXPECT().FindAll(gomock.Any(), gomock.Any(), expectedParams).Return(&domain_post.FindAllResult{
+			Posts:      expectedPosts,
+			NextCursor: "xxxxxx",
+			HasMore:    true,
+		}, nil)
+
+		// ユースケースのインスタンス化
+		postUsecase := NewPostUsecase(mockDB, mockTxManager, mockRepo, domain_post.TextRule{}, mockAuthorizer, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		result, err := postUsecase.FindAll(ctx, domain_post.FindAllParams{
+			AuthorUID:     "xxxx-xxxx-xxxx-0001",
+			CreatedAfter:  createdAfter,
+			CreatedBefore: createdBefore,
+		})
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, expectedPosts, result.Posts)
+		assert.Equal(t, "xxxxxx", result.NextCursor)
+		assert.True(t, result.HasMore)
+	})
+
+	t.Run("取得件数が上限を超える場合に上限に補正されること", func(t *testing.T) {
+		// モック化
+		expectedParams := domain_post.FindAllParams{Limit: domain_post.MaxLimit}
+		mockRepo.EXPECT().FindAll(gomock.Any(), gomock.Any(), expectedParams).Return(&domain_post.FindAllResult{}, nil)
+
+		// ユースケースのインスタンス化
+		postUsecase := NewPostUsecase(mockDB, mockTxManager, mockRepo, domain_post.TextRule{}, mockAuthorizer, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		_, err := postUsecase.FindAll(ctx, domain_post.FindAllParams{Limit: domain_post.MaxLimit + 1})
+
+		// 検証
+		assert.NoError(t, err)
+	})
+
+	t.Run("created_afterがcreated_beforeより後の場合にバリデーションエラーを返すこと", func(t *testing.T) {
+		// モック化
+		mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), "error", gomock.Any()).Return()
+
+		// ユースケースのインスタンス化
+		postUsecase := NewPostUsecase(mockDB, mockTxManager, mockRepo, domain_post.TextRule{}, mockAuthorizer, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		result, err := postUsecase.FindAll(ctx, domain_post.FindAllParams{
+			CreatedAfter:  createdBefore,
+			CreatedBefore: createdAfter,
+		})
+
+		// 検証
+		assert.Nil(t, result)
+		var errValidation *apperror.ErrValidation
+		assert.ErrorAs(t, err, &errValidation)
+		var errInvalidPeriod *domain_post.ErrInvalidPeriod
+		assert.ErrorAs(t, err, &errInvalidPeriod)
+	})
+
+	t.Run("カーソルが不正な場合に不正なリクエストのエラーを返すこと", func(t *testing.T) {
+		// モック化
+		mockRepo.EXPECT().FindAll(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &apperror.ErrBadRequest{Err: fmt.Errorf("カーソルの値が不正です。")})
+
+		// ユースケースのインスタンス化
+		postUsecase := NewPostUsecase(mockDB, mockTxManager, mockRepo, domain_post.TextRule{}, mockAuthorizer, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		result, err := postUsecase.FindAll(ctx, domain_post.FindAllParams{Cursor: "xxxxxx"})
+
+		// 検証
+		assert.Nil(t, result)
+		var errBadRequest *apperror.ErrBadRequest
+		assert.ErrorAs(t, err, &errBadRequest)
+	})
+}
@@ -0,0 +1,22 @@
+package post
+
+import (
+	"context"
+
+	"go-gin-domain/internal/domain/apperror"
+	domain_post "go-gin-domain/internal/domain/post"
+)
+
+func (u *postUsecase) FindByID(ctx context.Context, id int64) (*domain_post.Post, error) {
+	post, err := u.postRepo.FindByID(ctx, u.db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 対象のPostが存在しない場合はエラー
+	if post == nil {
+		return nil, &apperror.ErrNotFound{Err: &ErrPostNotFound{}}
+	}
+
+	return post, nil
+}
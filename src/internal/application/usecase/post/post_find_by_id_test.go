@@ -0,0 +1,101 @@
+//go:build unit
+
+package post
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	mockAuthorization "go-gin-domain/internal/application/usecase/authorization/mock_authorization"
+	mockLogger "go-gin-domain/internal/application/usecase/logger/mock_logger"
+	mockTransaction "go-gin-domain/internal/application/usecase/transaction/mock_transaction"
+	"go-gin-domain/internal/domain/apperror"
+	domain_post "go-gin-domain/internal/domain/post"
+	mockPost "go-gin-domain/internal/domain/post/mock_post_repository"
+
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 初期処理
+func init() {
+	// テスト用の環境変数ファイル「.env.testing」を読み込んで使用する。
+	if err := godotenv.Load("../../../../.env.testing"); err != nil {
+		fmt.Println(".env.testingの読み込みに失敗しました。")
+	}
+}
+
+func TestPostUsecase_FindByID(t *testing.T) {
+	// DBのモック
+	mockDB := &sql.DB{}
+
+	// リポジトリのモック
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mockPost.NewMockPostRepository(ctrl)
+
+	// ロガーのモック
+	mockLogger := mockLogger.NewMockLogger(ctrl)
+
+	// トランザクション管理のモック
+	mockTxManager := mockTransaction.NewMockTxManager(ctrl)
+
+	// 認可のモック
+	mockAuthorizer := mockAuthorization.NewMockAuthorizer(ctrl)
+
+	t.Run("正常終了すること", func(t *testing.T) {
+		// モック化
+		expectedPost := domain_post.ReconstitutePost(1, "こんにちは", "xxxx-xxxx-xxxx-0001", time.Time{}, time.Time{}, nil)
+		mockRepo.EXPECT().FindByID(gomock.Any(), gomock.Any(), int64(1)).Return(expectedPost, nil)
+
+		// ユースケースのインスタンス化
+		postUsecase := NewPostUsecase(mockDB, mockTxManager, mockRepo, domain_post.TextRule{}, mockAuthorizer, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		post, err := postUsecase.FindByID(ctx, 1)
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, expectedPost, post)
+	})
+
+	t.Run("対象のPostが存在しない場合にErrNotFoundを返すこと", func(t *testing.T) {
+		// モック化（論理削除済みのPostもリポジトリはnilを返す）
+		mockRepo.EXPECT().FindByID(gomock.Any(), gomock.Any(), int64(1)).Return(nil, nil)
+
+		// ユースケースのインスタンス化
+		postUsecase := NewPostUsecase(mockDB, mockTxManager, mockRepo, domain_post.TextRule{}, mockAuthorizer, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		post, err := postUsecase.FindByID(ctx, 1)
+
+		// 検証
+		assert.Nil(t, post)
+		var errNotFound *apperror.ErrNotFound
+		assert.ErrorAs(t, err, &errNotFound)
+		var errPostNotFound *ErrPostNotFound
+		assert.ErrorAs(t, err, &errPostNotFound)
+	})
+
+	t.Run("取得でエラーの場合にエラーを返すこと", func(t *testing.T) {
+		// モック化
+		mockRepo.EXPECT().FindByID(gomock.Any(), gomock.Any(), int64(1)).Return(nil, fmt.Errorf("Internal Server Error"))
+
+		// ユースケースのインスタンス化
+		postUsecase := NewPostUsecase(mockDB, mockTxManager, mockRepo, domain_post.TextRule{}, mockAuthorizer, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		post, err := postUsecase.FindByID(ctx, 1)
+
+		// 検証
+		assert.Error(t, err)
+		assert.Nil(t, post)
+	})
+}
@@ -0,0 +1,55 @@
+package post
+
+import (
+	"context"
+
+	"go-gin-domain/internal/application/usecase/authorization"
+	"go-gin-domain/internal/application/usecase/metrics"
+	domain_post "go-gin-domain/internal/domain/post"
+)
+
+// メトリクスを記録するためのラッパー
+type metricsPostUsecase struct {
+	next     PostUsecase
+	recorder metrics.Recorder
+}
+
+// ユースケースの呼び出し結果をメトリクスとして記録する
+func NewMetricsPostUsecase(next PostUsecase, recorder metrics.Recorder) PostUsecase {
+	return &metricsPostUsecase{
+		next:     next,
+		recorder: recorder,
+	}
+}
+
+const usecaseName = "post"
+
+func (u *metricsPostUsecase) Create(ctx context.Context, authorUID, text string) (*domain_post.Post, error) {
+	post, err := u.next.Create(ctx, authorUID, text)
+	u.recorder.ObserveUsecase(usecaseName, "Create", err)
+	return post, err
+}
+
+func (u *metricsPostUsecase) FindAll(ctx context.Context, params domain_post.FindAllParams) (*domain_post.FindAllResult, error) {
+	result, err := u.next.FindAll(ctx, params)
+	u.recorder.ObserveUsecase(usecaseName, "FindAll", err)
+	return result, err
+}
+
+func (u *metricsPostUsecase) FindByID(ctx context.Context, id int64) (*domain_post.Post, error) {
+	post, err := u.next.FindByID(ctx, id)
+	u.recorder.ObserveUsecase(usecaseName, "FindByID", err)
+	return post, err
+}
+
+func (u *metricsPostUsecase) Update(ctx context.Context, actor authorization.Actor, id int64, text string) (*domain_post.Post, error) {
+	post, err := u.next.Update(ctx, actor, id, text)
+	u.recorder.ObserveUsecase(usecaseName, "Update", err)
+	return post, err
+}
+
+func (u *metricsPostUsecase) Delete(ctx context.Context, actor authorization.Actor, id int64) (*domain_post.Post, error) {
+	post, err := u.next.Delete(ctx, actor, id)
+	u.recorder.ObserveUsecase(usecaseName, "Delete", err)
+	return post, err
+}
@@ -0,0 +1,70 @@
+package post
+
+import (
+	"context"
+
+	"go-gin-domain/internal/application/usecase/authorization"
+	"go-gin-domain/internal/application/usecase/tracing"
+	domain_post "go-gin-domain/internal/domain/post"
+
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("go-gin-domain/internal/application/usecase/post")
+
+// トレースのスパンを記録するためのラッパー
+type tracingPostUsecase struct {
+	next PostUsecase
+}
+
+// 各メソッドの呼び出しをスパンとして記録する
+func NewTracingPostUsecase(next PostUsecase) PostUsecase {
+	return &tracingPostUsecase{
+		next: next,
+	}
+}
+
+func (u *tracingPostUsecase) Create(ctx context.Context, authorUID, text string) (*domain_post.Post, error) {
+	ctx, span := tracer.Start(ctx, "PostUsecase.Create")
+	defer span.End()
+
+	result, err := u.next.Create(ctx, authorUID, text)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (u *tracingPostUsecase) FindAll(ctx context.Context, params domain_post.FindAllParams) (*domain_post.FindAllResult, error) {
+	ctx, span := tracer.Start(ctx, "PostUsecase.FindAll")
+	defer span.End()
+
+	result, err := u.next.FindAll(ctx, params)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (u *tracingPostUsecase) FindByID(ctx context.Context, id int64) (*domain_post.Post, error) {
+	ctx, span := tracer.Start(ctx, "PostUsecase.FindByID")
+	defer span.End()
+
+	result, err := u.next.FindByID(ctx, id)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (u *tracingPostUsecase) Update(ctx context.Context, actor authorization.Actor, id int64, text string) (*domain_post.Post, error) {
+	ctx, span := tracer.Start(ctx, "PostUsecase.Update")
+	defer span.End()
+
+	result, err := u.next.Update(ctx, actor, id, text)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (u *tracingPostUsecase) Delete(ctx context.Context, actor authorization.Actor, id int64) (*domain_post.Post, error) {
+	ctx, span := tracer.Start(ctx, "PostUsecase.Delete")
+	defer span.End()
+
+	result, err := u.next.Delete(ctx, actor, id)
+	tracing.RecordError(span, err)
+	return result, err
+}
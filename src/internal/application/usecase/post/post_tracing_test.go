@@ -0,0 +1,159 @@
+//go:build unit
+
+package post
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go-gin-domain/internal/application/usecase/authorization"
+	mockPost "go-gin-domain/internal/application/usecase/post/mock_post"
+	domain_post "go-gin-domain/internal/domain/post"
+	domain_user "go-gin-domain/internal/domain/user"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+)
+
+// 終了したスパンを記録する（パッケージのtracerは最初に設定したプロバイダーを使い続けるため、1度だけ設定する）
+var spanRecorder = func() *tracetest.SpanRecorder {
+	sr := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	return sr
+}()
+
+func TestTracingPostUsecase(t *testing.T) {
+	// ユースケースのモック
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockPostUsecase := mockPost.NewMockPostUsecase(ctrl)
+
+	u := NewTracingPostUsecase(mockPostUsecase)
+
+	// 呼び出し元のスパン
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	defer parent.End()
+
+	// 次の処理に渡されたコンテキストのスパン
+	var nextSpan trace.SpanContext
+
+	// 操作するユーザー
+	actor := authorization.Actor{UID: "xxxx-xxxx-xxxx-0001", Role: domain_user.RoleMember}
+
+	tests := []struct {
+		spanName string
+		// errを返すようにモック化して呼び出す
+		call func(err error) error
+	}{
+		{
+			spanName: "PostUsecase.Create",
+			call: func(err error) error {
+				mockPostUsecase.EXPECT().Create(gomock.Any(), "xxxx-xxxx-xxxx-0001", "こんにちは").DoAndReturn(
+					func(ctx context.Context, _, _ string) (*domain_post.Post, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = u.Create(ctx, "xxxx-xxxx-xxxx-0001", "こんにちは")
+				return err
+			},
+		},
+		{
+			spanName: "PostUsecase.FindAll",
+			call: func(err error) error {
+				mockPostUsecase.EXPECT().FindAll(gomock.Any(), domain_post.FindAllParams{}).DoAndReturn(
+					func(ctx context.Context, _ domain_post.FindAllParams) (*domain_post.FindAllResult, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = u.FindAll(ctx, domain_post.FindAllParams{})
+				return err
+			},
+		},
+		{
+			spanName: "PostUsecase.FindByID",
+			call: func(err error) error {
+				mockPostUsecase.EXPECT().FindByID(gomock.Any(), int64(1)).DoAndReturn(
+					func(ctx context.Context, _ int64) (*domain_post.Post, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = u.FindByID(ctx, 1)
+				return err
+			},
+		},
+		{
+			spanName: "PostUsecase.Update",
+			call: func(err error) error {
+				mockPostUsecase.EXPECT().Update(gomock.Any(), actor, int64(1), "こんばんは").DoAndReturn(
+					func(ctx context.Context, _ authorization.Actor, _ int64, _ string) (*domain_post.Post, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = u.Update(ctx, actor, 1, "こんばんは")
+				return err
+			},
+		},
+		{
+			spanName: "PostUsecase.Delete",
+			call: func(err error) error {
+				mockPostUsecase.EXPECT().Delete(gomock.Any(), actor, int64(1)).DoAndReturn(
+					func(ctx context.Context, _ authorization.Actor, _ int64) (*domain_post.Post, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = u.Delete(ctx, actor, 1)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spanName+"のスパンを呼び出し元の子として記録すること", func(t *testing.T) {
+			spanRecorder.Reset()
+
+			// テストの実行
+			err := tt.call(nil)
+
+			// 検証
+			assert.NoError(t, err)
+			spans := spanRecorder.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			assert.Equal(t, tt.spanName, span.Name())
+			assert.Equal(t, parent.SpanContext().TraceID(), span.SpanContext().TraceID())
+			assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+			assert.Equal(t, span.SpanContext().SpanID(), nextSpan.SpanID())
+			assert.Equal(t, codes.Unset, span.Status().Code)
+		})
+
+		t.Run(tt.spanName+"でエラーの場合にスパンにエラーを記録すること", func(t *testing.T) {
+			spanRecorder.Reset()
+
+			// テストの実行
+			err := tt.call(errors.New("Internal Server Error"))
+
+			// 検証
+			assert.Error(t, err)
+			spans := spanRecorder.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			assert.Equal(t, tt.spanName, span.Name())
+			assert.Equal(t, codes.Error, span.Status().Code)
+			assert.Equal(t, "Internal Server Error", span.Status().Description)
+			require.Len(t, span.Events(), 1)
+			assert.Equal(t, "exception", span.Events()[0].Name)
+		})
+	}
+}
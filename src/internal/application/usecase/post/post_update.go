@@ -0,0 +1,51 @@
+package post
+
+import (
+	"context"
+
+	"go-gin-domain/internal/application/usecase/authorization"
+	"go-gin-domain/internal/domain/apperror"
+	domain_post "go-gin-domain/internal/domain/post"
+	"go-gin-domain/internal/domain/repository"
+)
+
+func (u *postUsecase) Update(ctx context.Context, actor authorization.Actor, id int64, text string) (*domain_post.Post, error) {
+	// 値オブジェクトを利用してtextをチェック
+	newText, err := u.textRule.NewText(text)
+	if err != nil {
+		err := apperror.NewErrValidation("text", err)
+		u.logger.Warn(ctx, "Postの入力値が不正です。", "postId", id, "error", err)
+		return nil, err
+	}
+
+	var updatePost *domain_post.Post
+
+	// 取得から更新までをトランザクション内で実行
+	err = u.txManager.Do(ctx, func(ctx context.Context, tx repository.DB) error {
+		post, err := u.postRepo.FindByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		// 対象のPostが存在しない場合はエラー
+		if post == nil {
+			return &apperror.ErrNotFound{Err: &ErrPostNotFound{}}
+		}
+
+		// 投稿者またはadmin以外の場合はエラー
+		if err := u.authorizer.Authorize(ctx, actor, authorization.PermissionUpdatePost, post.AuthorUID()); err != nil {
+			return err
+		}
+
+		// textの更新
+		post.UpdateText(newText)
+
+		updatePost, err = u.postRepo.Save(ctx, tx, post)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updatePost, nil
+}
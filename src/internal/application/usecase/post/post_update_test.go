@@ -0,0 +1,231 @@
+//go:build unit
+
+package post
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"go-gin-domain/internal/application/usecase/authorization"
+	mockAuthorization "go-gin-domain/internal/application/usecase/authorization/mock_authorization"
+	mockLogger "go-gin-domain/internal/application/usecase/logger/mock_logger"
+	mockTransaction "go-gin-domain/internal/application/usecase/transaction/mock_transaction"
+	"go-gin-domain/internal/domain/apperror"
+	domain_post "go-gin-domain/internal/domain/post"
+	mockPost "go-gin-domain/internal/domain/post/mock_post_repository"
+	"go-gin-domain/internal/domain/repository"
+	domain_user "go-gin-domain/internal/domain/user"
+
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 初期処理
+func init() {
+	// テスト用の環境変数ファイル「.env.testing」を読み込んで使用する。
+	if err := godotenv.Load("../../../../.env.testing"); err != nil {
+		fmt.Println(".env.testingの読み込みに失敗しました。")
+	}
+}
+
+func TestPostUsecase_Update(t *testing.T) {
+	// DBのモック
+	mockDB := &sql.DB{}
+
+	// リポジトリのモック
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mockPost.NewMockPostRepository(ctrl)
+
+	// ロガーのモック
+	mockLogger := mockLogger.NewMockLogger(ctrl)
+
+	// トランザクション管理のモック
+	mockTxManager := mockTransaction.NewMockTxManager(ctrl)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context, repository.DB) error) error {
+			return fn(ctx, mockDB)
+		},
+	).AnyTimes()
+
+	// 認可のモック
+	mockAuthorizer := mockAuthorization.NewMockAuthorizer(ctrl)
+
+	// 操作するユーザー
+	actor := authorization.Actor{UID: "xxxx-xxxx-xxxx-0001", Role: domain_user.RoleMember}
+
+	t.Run("正常終了すること", func(t *testing.T) {
+		// モック化
+		findPost := domain_post.ReconstitutePost(1, "こんにちは", "xxxx-xxxx-xxxx-0001", time.Time{}, time.Time{}, nil)
+		mockRepo.EXPECT().FindByIDForUpdate(gomock.Any(), gomock.Any(), int64(1)).Return(findPost, nil)
+		mockAuthorizer.EXPECT().Authorize(gomock.Any(), actor, authorization.PermissionUpdatePost, "xxxx-xxxx-xxxx-0001").Return(nil)
+		mockRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ repository.DB, post *domain_post.Post) (*domain_post.Post, error) {
+				return post, nil
+			},
+		)
+
+		// ユースケースのインスタンス化
+		postUsecase := NewPostUsecase(mockDB, mockTxManager, mockRepo, domain_post.TextRule{}, mockAuthorizer, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		post, err := postUsecase.Update(ctx, actor, 1, "こんばんは")
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, "こんばんは", post.TextValue())
+		assert.NotEqual(t, post.CreatedAt(), post.UpdatedAt())
+		assert.Nil(t, post.DeletedAt())
+	})
+
+	t.Run("textが不正な場合にバリデーションエラーを返すこと", func(t *testing.T) {
+		// モック化
+		mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), "postId", int64(1), "error", gomock.Any()).Return()
+
+		// ユースケースのインスタンス化
+		postUsecase := NewPostUsecase(mockDB, mockTxManager, mockRepo, domain_post.TextRule{}, mockAuthorizer, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		post, err := postUsecase.Update(ctx, actor, 1, "")
+
+		// 検証
+		assert.Nil(t, post)
+		var errValidation *apperror.ErrValidation
+		assert.ErrorAs(t, err, &errValidation)
+	})
+
+	t.Run("対象のPostが存在しないまたは論理削除済みの場合にErrNotFoundを返すこと", func(t *testing.T) {
+		// モック化
+		mockRepo.EXPECT().FindByIDForUpdate(gomock.Any(), gomock.Any(), int64(1)).Return(nil, nil)
+
+		// ユースケースのインスタンス化
+		postUsecase := NewPostUsecase(mockDB, mockTxManager, mockRepo, domain_post.TextRule{}, mockAuthorizer, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		post, err := postUsecase.Update(ctx, actor, 1, "こんばんは")
+
+		// 検証
+		assert.Nil(t, post)
+		var errNotFound *apperror.ErrNotFound
+		assert.ErrorAs(t, err, &errNotFound)
+	})
+
+	t.Run("投稿者でない場合にErrForbiddenを返し、更新しないこと", func(t *testing.T) {
+		// モック化
+		findPost := domain_post.ReconstitutePost(1, "こんにちは", "xxxx-xxxx-xxxx-0002", time.Time{}, time.Time{}, nil)
+		mockRepo.EXPECT().FindByIDForUpdate(gomock.Any(), gomock.Any(), int64(1)).Return(findPost, nil)
+		mockAuthorizer.EXPECT().Authorize(gomock.Any(), actor, authorization.PermissionUpdatePost, "xxxx-xxxx-xxxx-0002").Return(&apperror.ErrForbidden{Err: &authorization.ErrPermissionDenied{}})
+		mockRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// ユースケースのインスタンス化
+		postUsecase := NewPostUsecase(mockDB, mockTxManager, mockRepo, domain_post.TextRule{}, mockAuthorizer, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		post, err := postUsecase.Update(ctx, actor, 1, "こんばんは")
+
+		// 検証
+		assert.Nil(t, post)
+		var errForbidden *apperror.ErrForbidden
+		assert.ErrorAs(t, err, &errForbidden)
+	})
+
+	t.Run("更新でエラーの場合にエラーを返すこと", func(t *testing.T) {
+		// モック化
+		findPost := domain_post.ReconstitutePost(1, "こんにちは", "xxxx-xxxx-xxxx-0001", time.Time{}, time.Time{}, nil)
+		mockRepo.EXPECT().FindByIDForUpdate(gomock.Any(), gomock.Any(), int64(1)).Return(findPost, nil)
+		mockAuthorizer.EXPECT().Authorize(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mockRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("Internal Server Error"))
+
+		// ユースケースのインスタンス化
+		postUsecase := NewPostUsecase(mockDB, mockTxManager, mockRepo, domain_post.TextRule{}, mockAuthorizer, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		post, err := postUsecase.Update(ctx, actor, 1, "こんばんは")
+
+		// 検証
+		assert.Error(t, err)
+		assert.Nil(t, post)
+	})
+}
+
+func TestPostUsecase_Update_Authorization(t *testing.T) {
+	// DBのモック
+	mockDB := &sql.DB{}
+
+	// リポジトリのモック
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mockPost.NewMockPostRepository(ctrl)
+
+	// ロガーのモック
+	mockLogger := mockLogger.NewMockLogger(ctrl)
+
+	// トランザクション管理のモック
+	mockTxManager := mockTransaction.NewMockTxManager(ctrl)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context, repository.DB) error) error {
+			return fn(ctx, mockDB)
+		},
+	).AnyTimes()
+
+	// 認可はデフォルトの権限で判定する（操作するユーザーの取得は利用しない）
+	authorizer := authorization.NewAuthorizer(mockDB, nil, authorization.DefaultPolicy(), mockLogger)
+
+	// 投稿者以外のユーザーのPost
+	authorUID := "xxxx-xxxx-xxxx-0002"
+
+	tests := []struct {
+		name    string
+		actor   authorization.Actor
+		allowed bool
+	}{
+		{"adminは他のユーザーのPostを更新できること", authorization.Actor{UID: "xxxx-xxxx-xxxx-0001", Role: domain_user.RoleAdmin}, true},
+		{"memberは他のユーザーのPostを更新できないこと", authorization.Actor{UID: "xxxx-xxxx-xxxx-0001", Role: domain_user.RoleMember}, false},
+		{"memberは自分のPostを更新できること", authorization.Actor{UID: authorUID, Role: domain_user.RoleMember}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// モック化
+			findPost := domain_post.ReconstitutePost(1, "こんにちは", authorUID, time.Time{}, time.Time{}, nil)
+			mockRepo.EXPECT().FindByIDForUpdate(gomock.Any(), gomock.Any(), int64(1)).Return(findPost, nil)
+			if tt.allowed {
+				mockRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ repository.DB, post *domain_post.Post) (*domain_post.Post, error) {
+						return post, nil
+					},
+				)
+			} else {
+				mockLogger.EXPECT().Warn(gomock.Any(), "操作を拒否しました。", gomock.Any()).Return()
+			}
+
+			// ユースケースのインスタンス化
+			postUsecase := NewPostUsecase(mockDB, mockTxManager, mockRepo, domain_post.TextRule{}, authorizer, mockLogger)
+
+			// テストの実行
+			ctx := context.Background()
+			post, err := postUsecase.Update(ctx, tt.actor, 1, "こんばんは")
+
+			// 検証
+			if tt.allowed {
+				assert.NoError(t, err)
+				assert.NotNil(t, post)
+				return
+			}
+			assert.Nil(t, post)
+			var errForbidden *apperror.ErrForbidden
+			assert.ErrorAs(t, err, &errForbidden)
+			var errPermissionDenied *authorization.ErrPermissionDenied
+			assert.ErrorAs(t, err, &errPermissionDenied)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package transaction
+
+import (
+	"context"
+
+	"go-gin-domain/internal/domain/repository"
+)
+
+type TxManager interface {
+	// fnをトランザクション内で実行する。
+	// fnがエラーを返した場合やpanicした場合はロールバックし、正常終了した場合はコミットする。
+	// リポジトリにはパラメータのtxを渡すこと。
+	Do(ctx context.Context, fn func(ctx context.Context, tx repository.DB) error) error
+}
@@ -72,18 +72,18 @@ func (mr *MockUserUsecaseMockRecorder) Delete(ctx, uid any) *gomock.Call {
 }
 
 // FindAll mocks base method.
-func (m *MockUserUsecase) FindAll(ctx context.Context) ([]*user.User, error) {
+func (m *MockUserUsecase) FindAll(ctx context.Context, params user.FindAllParams) (*user.FindAllResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx)
-	ret0, _ := ret[0].([]*user.User)
+	ret := m.ctrl.Call(m, "FindAll", ctx, params)
+	ret0, _ := ret[0].(*user.FindAllResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockUserUsecaseMockRecorder) FindAll(ctx any) *gomock.Call {
+func (mr *MockUserUsecaseMockRecorder) FindAll(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockUserUsecase)(nil).FindAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockUserUsecase)(nil).FindAll), ctx, params)
 }
 
 // FindByUID mocks base method.
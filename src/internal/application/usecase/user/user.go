@@ -4,27 +4,38 @@ import (
 	"context"
 
 	"go-gin-domain/internal/application/usecase/logger"
+	"go-gin-domain/internal/application/usecase/transaction"
+	"go-gin-domain/internal/domain/repository"
 	domain_user "go-gin-domain/internal/domain/user"
 )
 
+// カスタムエラー用の構造体を定義
+type ErrUserNotFound struct{}
+
+func (e *ErrUserNotFound) Error() string {
+	return "対象ユーザーが存在しません。"
+}
+
 type UserUsecase interface {
 	Create(ctx context.Context, lastName, firstName, email string) (*domain_user.User, error)
-	FindAll(ctx context.Context) ([]*domain_user.User, error)
+	FindAll(ctx context.Context, params domain_user.FindAllParams) (*domain_user.FindAllResult, error)
 	FindByUID(ctx context.Context, uid string) (*domain_user.User, error)
 	Update(ctx context.Context, uid, lastName, firstName, email string) (*domain_user.User, error)
 	Delete(ctx context.Context, uid string) (*domain_user.User, error)
 }
 
 type userUsecase struct {
-	db       string
-	userRepo domain_user.UserRepository
-	logger   logger.Logger
+	db        repository.DB
+	txManager transaction.TxManager
+	userRepo  domain_user.UserRepository
+	logger    logger.Logger
 }
 
-func NewUserUsecase(db string, userRepo domain_user.UserRepository, logger logger.Logger) UserUsecase {
+func NewUserUsecase(db repository.DB, txManager transaction.TxManager, userRepo domain_user.UserRepository, logger logger.Logger) UserUsecase {
 	return &userUsecase{
-		db:       db,
-		userRepo: userRepo,
-		logger:   logger,
+		db:        db,
+		txManager: txManager,
+		userRepo:  userRepo,
+		logger:    logger,
 	}
 }
@@ -4,16 +4,24 @@ import (
 	"context"
 
 	domain_user "go-gin-domain/internal/domain/user"
-
-	"github.com/google/uuid"
 )
 
 func (u *userUsecase) Create(ctx context.Context, lastName, firstName, email string) (*domain_user.User, error) {
 	// UIDの設定（仮）
-	uid := uuid.New().String()
+	uid := domain_user.GenerateUID().Value()
 
 	// 新規ユーザー作成
-	user := domain_user.NewUser(uid, lastName, firstName, email)
+	user, err := domain_user.NewUser(uid, lastName, firstName, email)
+	if err != nil {
+		u.logger.Warn(ctx, "ユーザーの入力値が不正です。", "error", err)
+		return nil, err
+	}
+
+	// メールアドレスの重複チェック
+	if err := domain_user.EnsureUniqueEmail(ctx, u.db, u.userRepo, user); err != nil {
+		u.logger.Warn(ctx, "ユーザーを登録できません。", "error", err)
+		return nil, err
+	}
 
 	return u.userRepo.Create(ctx, u.db, user)
 }
@@ -4,11 +4,13 @@ package user
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 	"time"
 
 	mockLogger "go-gin-domain/internal/application/usecase/logger/mock_logger"
+	mockTransaction "go-gin-domain/internal/application/usecase/transaction/mock_transaction"
 	domain_user "go-gin-domain/internal/domain/user"
 	mockUser "go-gin-domain/internal/domain/user/mock_user_repository"
 
@@ -27,7 +29,7 @@ func init() {
 
 func TestUserUsecase_Create(t *testing.T) {
 	// DBのモック
-	mockDB := "dummy"
+	mockDB := &sql.DB{}
 
 	// リポジトリのモック
 	ctrl := gomock.NewController(t)
@@ -37,6 +39,9 @@ func TestUserUsecase_Create(t *testing.T) {
 	// ロガーのモック
 	mockLogger := mockLogger.NewMockLogger(ctrl)
 
+	// トランザクション管理のモック
+	mockTxManager := mockTransaction.NewMockTxManager(ctrl)
+
 	t.Run("正常終了すること", func(t *testing.T) {
 		// モック化
 		expectedUser := &domain_user.User{
@@ -49,10 +54,11 @@ func TestUserUsecase_Create(t *testing.T) {
 			UpdatedAt: time.Time{},
 			DeletedAt: nil,
 		}
+		mockRepo.EXPECT().FindByEmail(gomock.Any(), gomock.Any(), "t.tanaka@example.com").Return(nil, nil)
 		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedUser, nil)
 
 		// ユースケースのインスタンス化
-		userUsecase := NewUserUsecase(mockDB, mockRepo, mockLogger)
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
 
 		// テストの実行
 		ctx := context.Background()
@@ -74,4 +80,44 @@ func TestUserUsecase_Create(t *testing.T) {
 		assert.NotNil(t, user.UpdatedAt)
 		assert.Nil(t, user.DeletedAt)
 	})
+
+	t.Run("バリデーションエラーの場合にリポジトリを呼ばずにエラーを返すこと", func(t *testing.T) {
+		// モック化
+		mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), "error", gomock.Any()).Return()
+
+		// ユースケースのインスタンス化
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		user, err := userUsecase.Create(ctx, "田中", "太郎", "invalid-email")
+
+		// 検証
+		var errInvalidEmail *domain_user.ErrInvalidEmail
+		assert.ErrorAs(t, err, &errInvalidEmail)
+		assert.Nil(t, user)
+	})
+
+	t.Run("メールアドレスが登録済みの場合にErrEmailAlreadyExistsを返すこと", func(t *testing.T) {
+		// モック化
+		existingUser := &domain_user.User{
+			ID:    2,
+			UID:   "xxxx-xxxx-xxxx-0002",
+			Email: "t.tanaka@example.com",
+		}
+		mockRepo.EXPECT().FindByEmail(gomock.Any(), gomock.Any(), "t.tanaka@example.com").Return(existingUser, nil)
+		mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), "error", gomock.Any()).Return()
+
+		// ユースケースのインスタンス化
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		user, err := userUsecase.Create(ctx, "田中", "太郎", "T.Tanaka@example.com")
+
+		// 検証
+		var errEmailAlreadyExists *domain_user.ErrEmailAlreadyExists
+		assert.ErrorAs(t, err, &errEmailAlreadyExists)
+		assert.Nil(t, user)
+	})
 }
@@ -2,26 +2,37 @@ package user
 
 import (
 	"context"
-	"fmt"
 
+	"go-gin-domain/internal/domain/apperror"
+	"go-gin-domain/internal/domain/repository"
 	domain_user "go-gin-domain/internal/domain/user"
 )
 
 func (u *userUsecase) Delete(ctx context.Context, uid string) (*domain_user.User, error) {
-	user, err := u.userRepo.FindByUID(ctx, u.db, uid)
+	var deleteUser *domain_user.User
+
+	// 取得から論理削除までをトランザクション内で実行
+	err := u.txManager.Do(ctx, func(ctx context.Context, tx repository.DB) error {
+		user, err := u.userRepo.FindByUIDForUpdate(ctx, tx, uid)
+		if err != nil {
+			return err
+		}
+
+		// 対象ユーザーが存在しない場合はエラー
+		if user == nil {
+			u.logger.Warn(ctx, (&ErrUserNotFound{}).Error(), "uid", uid)
+			return &apperror.ErrNotFound{Err: &ErrUserNotFound{}}
+		}
+
+		// 論理削除設定
+		user.SetDelete()
+
+		deleteUser, err = u.userRepo.Save(ctx, tx, user)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// 対象ユーザーが存在しない場合はエラー
-	if user == nil {
-		msg := fmt.Sprintf("対象ユーザーが存在しません。: UID=%s", uid)
-		u.logger.Error(ctx, msg)
-		return nil, fmt.Errorf("%s", msg)
-	}
-
-	// 論理削除設定
-	user.SetDelete()
-
-	return u.userRepo.Save(ctx, u.db, user)
+	return deleteUser, nil
 }
@@ -4,11 +4,15 @@ package user
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 	"time"
 
 	mockLogger "go-gin-domain/internal/application/usecase/logger/mock_logger"
+	mockTransaction "go-gin-domain/internal/application/usecase/transaction/mock_transaction"
+	"go-gin-domain/internal/domain/apperror"
+	"go-gin-domain/internal/domain/repository"
 	domain_user "go-gin-domain/internal/domain/user"
 	mockUser "go-gin-domain/internal/domain/user/mock_user_repository"
 
@@ -27,7 +31,7 @@ func init() {
 
 func TestUserUsecase_Delete(t *testing.T) {
 	// DBのモック
-	mockDB := "dummy"
+	mockDB := &sql.DB{}
 
 	// リポジトリのモック
 	ctrl := gomock.NewController(t)
@@ -37,6 +41,14 @@ func TestUserUsecase_Delete(t *testing.T) {
 	// ロガーのモック
 	mockLogger := mockLogger.NewMockLogger(ctrl)
 
+	// トランザクション管理のモック
+	mockTxManager := mockTransaction.NewMockTxManager(ctrl)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context, repository.DB) error) error {
+			return fn(ctx, mockDB)
+		},
+	).AnyTimes()
+
 	t.Run("正常終了すること", func(t *testing.T) {
 		// モック化
 		findUser := &domain_user.User{
@@ -49,7 +61,7 @@ func TestUserUsecase_Delete(t *testing.T) {
 			UpdatedAt: time.Time{},
 			DeletedAt: nil,
 		}
-		mockRepo.EXPECT().FindByUID(gomock.Any(), gomock.Any(), gomock.Any()).Return(findUser, nil)
+		mockRepo.EXPECT().FindByUIDForUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(findUser, nil)
 
 		date := time.Now()
 		dateString := date.Format("2006-01-02 15:04:05")
@@ -67,7 +79,7 @@ func TestUserUsecase_Delete(t *testing.T) {
 		mockRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedUser, nil)
 
 		// ユースケースのインスタンス化
-		userUsecase := NewUserUsecase(mockDB, mockRepo, mockLogger)
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
 
 		// テストの実行
 		ctx := context.Background()
@@ -93,10 +105,10 @@ func TestUserUsecase_Delete(t *testing.T) {
 	t.Run("対象ユーザー取得でエラーの場合にエラーを返すこと", func(t *testing.T) {
 		// モック化
 		err := fmt.Errorf("Internal Server Error")
-		mockRepo.EXPECT().FindByUID(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, err)
+		mockRepo.EXPECT().FindByUIDForUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, err)
 
 		// ユースケースのインスタンス化
-		userUsecase := NewUserUsecase(mockDB, mockRepo, mockLogger)
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
 
 		// テストの実行
 		ctx := context.Background()
@@ -108,13 +120,13 @@ func TestUserUsecase_Delete(t *testing.T) {
 		assert.Nil(t, user)
 	})
 
-	t.Run("対象ユーザーが存在しない場合にエラーを返すこと", func(t *testing.T) {
+	t.Run("対象ユーザーが存在しない場合にErrNotFoundを返すこと", func(t *testing.T) {
 		// モック化
-		mockRepo.EXPECT().FindByUID(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-		mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Return()
+		mockRepo.EXPECT().FindByUIDForUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockLogger.EXPECT().Warn(gomock.Any(), "対象ユーザーが存在しません。", "uid", "xxxx-xxxx-xxxx-0001").Return()
 
 		// ユースケースのインスタンス化
-		userUsecase := NewUserUsecase(mockDB, mockRepo, mockLogger)
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
 
 		// テストの実行
 		ctx := context.Background()
@@ -122,7 +134,10 @@ func TestUserUsecase_Delete(t *testing.T) {
 		user, err := userUsecase.Delete(ctx, uid)
 
 		// 検証
-		assert.Error(t, err)
+		var errNotFound *apperror.ErrNotFound
+		assert.ErrorAs(t, err, &errNotFound)
+		var errUserNotFound *ErrUserNotFound
+		assert.ErrorAs(t, err, &errUserNotFound)
 		assert.Nil(t, user)
 	})
 }
@@ -6,6 +6,7 @@ import (
 	domain_user "go-gin-domain/internal/domain/user"
 )
 
-func (u *userUsecase) FindAll(ctx context.Context) ([]*domain_user.User, error) {
-	return u.userRepo.FindAll(ctx, u.db)
+func (u *userUsecase) FindAll(ctx context.Context, params domain_user.FindAllParams) (*domain_user.FindAllResult, error) {
+	// 未指定の項目にデフォルト値を設定
+	return u.userRepo.FindAll(ctx, u.db, params.Normalize())
 }
@@ -4,11 +4,13 @@ package user
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 	"time"
 
 	mockLogger "go-gin-domain/internal/application/usecase/logger/mock_logger"
+	mockTransaction "go-gin-domain/internal/application/usecase/transaction/mock_transaction"
 	domain_user "go-gin-domain/internal/domain/user"
 	mockUser "go-gin-domain/internal/domain/user/mock_user_repository"
 
@@ -27,7 +29,7 @@ func init() {
 
 func TestUserUsecase_FindAll(t *testing.T) {
 	// DBのモック
-	mockDB := "dummy"
+	mockDB := &sql.DB{}
 
 	// リポジトリのモック
 	ctrl := gomock.NewController(t)
@@ -37,6 +39,9 @@ func TestUserUsecase_FindAll(t *testing.T) {
 	// ロガーのモック
 	mockLogger := mockLogger.NewMockLogger(ctrl)
 
+	// トランザクション管理のモック
+	mockTxManager := mockTransaction.NewMockTxManager(ctrl)
+
 	t.Run("正常終了すること", func(t *testing.T) {
 		// モック化
 		expectedUsers := []*domain_user.User{
@@ -61,18 +66,31 @@ func TestUserUsecase_FindAll(t *testing.T) {
 				DeletedAt: nil,
 			},
 		}
-		mockRepo.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(expectedUsers, nil)
+		expectedParams := domain_user.FindAllParams{
+			Limit:     domain_user.DefaultLimit,
+			SortField: domain_user.SortByCreatedAt,
+			SortOrder: domain_user.SortAsc,
+		}
+		mockRepo.EXPECT().FindAll(gomock.Any(), gomock.Any(), expectedParams).Return(&domain_user.FindAllResult{
+			Users:      expectedUsers,
+			NextCursor: "xxxxxx",
+			HasMore:    true,
+		}, nil)
 
 		// ユースケースのインスタンス化
-		userUsecase := NewUserUsecase(mockDB, mockRepo, mockLogger)
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
 
 		// テストの実行
 		ctx := context.Background()
-		users, err := userUsecase.FindAll(ctx)
+		result, err := userUsecase.FindAll(ctx, domain_user.FindAllParams{})
 
 		// 検証
 		assert.NoError(t, err)
-		assert.NotNil(t, users)
+		assert.NotNil(t, result)
+		assert.Equal(t, "xxxxxx", result.NextCursor)
+		assert.True(t, result.HasMore)
+
+		users := result.Users
 		assert.Equal(t, len(expectedUsers), len(users))
 
 		assert.Equal(t, expectedUsers[0].ID, users[0].ID)
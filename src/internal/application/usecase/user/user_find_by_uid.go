@@ -3,9 +3,20 @@ package user
 import (
 	"context"
 
+	"go-gin-domain/internal/domain/apperror"
 	domain_user "go-gin-domain/internal/domain/user"
 )
 
 func (u *userUsecase) FindByUID(ctx context.Context, uid string) (*domain_user.User, error) {
-	return u.userRepo.FindByUID(ctx, u.db, uid)
+	user, err := u.userRepo.FindByUID(ctx, u.db, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	// 対象ユーザーが存在しない場合はエラー
+	if user == nil {
+		return nil, &apperror.ErrNotFound{Err: &ErrUserNotFound{}}
+	}
+
+	return user, nil
 }
@@ -4,11 +4,14 @@ package user
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 	"time"
 
 	mockLogger "go-gin-domain/internal/application/usecase/logger/mock_logger"
+	mockTransaction "go-gin-domain/internal/application/usecase/transaction/mock_transaction"
+	"go-gin-domain/internal/domain/apperror"
 	domain_user "go-gin-domain/internal/domain/user"
 	mockUser "go-gin-domain/internal/domain/user/mock_user_repository"
 
@@ -27,7 +30,7 @@ func init() {
 
 func TestUserUsecase_FindByUID(t *testing.T) {
 	// DBのモック
-	mockDB := "dummy"
+	mockDB := &sql.DB{}
 
 	// リポジトリのモック
 	ctrl := gomock.NewController(t)
@@ -37,6 +40,9 @@ func TestUserUsecase_FindByUID(t *testing.T) {
 	// ロガーのモック
 	mockLogger := mockLogger.NewMockLogger(ctrl)
 
+	// トランザクション管理のモック
+	mockTxManager := mockTransaction.NewMockTxManager(ctrl)
+
 	t.Run("正常終了すること", func(t *testing.T) {
 		// モック化
 		expectedUser := &domain_user.User{
@@ -52,7 +58,7 @@ func TestUserUsecase_FindByUID(t *testing.T) {
 		mockRepo.EXPECT().FindByUID(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedUser, nil)
 
 		// ユースケースのインスタンス化
-		userUsecase := NewUserUsecase(mockDB, mockRepo, mockLogger)
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
 
 		// テストの実行
 		ctx := context.Background()
@@ -72,4 +78,21 @@ func TestUserUsecase_FindByUID(t *testing.T) {
 		assert.NotNil(t, user.UpdatedAt)
 		assert.Nil(t, user.DeletedAt)
 	})
+
+	t.Run("対象ユーザーが存在しない場合にErrNotFoundを返すこと", func(t *testing.T) {
+		// モック化
+		mockRepo.EXPECT().FindByUID(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		// ユースケースのインスタンス化
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		user, err := userUsecase.FindByUID(ctx, "xxxx-xxxx-xxxx-0002")
+
+		// 検証
+		var errNotFound *apperror.ErrNotFound
+		assert.ErrorAs(t, err, &errNotFound)
+		assert.Nil(t, user)
+	})
 }
@@ -0,0 +1,54 @@
+package user
+
+import (
+	"context"
+
+	"go-gin-domain/internal/application/usecase/metrics"
+	domain_user "go-gin-domain/internal/domain/user"
+)
+
+// メトリクスを記録するためのラッパー
+type metricsUserUsecase struct {
+	next     UserUsecase
+	recorder metrics.Recorder
+}
+
+// ユースケースの呼び出し結果をメトリクスとして記録する
+func NewMetricsUserUsecase(next UserUsecase, recorder metrics.Recorder) UserUsecase {
+	return &metricsUserUsecase{
+		next:     next,
+		recorder: recorder,
+	}
+}
+
+const usecaseName = "user"
+
+func (u *metricsUserUsecase) Create(ctx context.Context, lastName, firstName, email string) (*domain_user.User, error) {
+	user, err := u.next.Create(ctx, lastName, firstName, email)
+	u.recorder.ObserveUsecase(usecaseName, "Create", err)
+	return user, err
+}
+
+func (u *metricsUserUsecase) FindAll(ctx context.Context, params domain_user.FindAllParams) (*domain_user.FindAllResult, error) {
+	result, err := u.next.FindAll(ctx, params)
+	u.recorder.ObserveUsecase(usecaseName, "FindAll", err)
+	return result, err
+}
+
+func (u *metricsUserUsecase) FindByUID(ctx context.Context, uid string) (*domain_user.User, error) {
+	user, err := u.next.FindByUID(ctx, uid)
+	u.recorder.ObserveUsecase(usecaseName, "FindByUID", err)
+	return user, err
+}
+
+func (u *metricsUserUsecase) Update(ctx context.Context, uid, lastName, firstName, email string) (*domain_user.User, error) {
+	user, err := u.next.Update(ctx, uid, lastName, firstName, email)
+	u.recorder.ObserveUsecase(usecaseName, "Update", err)
+	return user, err
+}
+
+func (u *metricsUserUsecase) Delete(ctx context.Context, uid string) (*domain_user.User, error) {
+	user, err := u.next.Delete(ctx, uid)
+	u.recorder.ObserveUsecase(usecaseName, "Delete", err)
+	return user, err
+}
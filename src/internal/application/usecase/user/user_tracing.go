@@ -0,0 +1,69 @@
+package user
+
+import (
+	"context"
+
+	"go-gin-domain/internal/application/usecase/tracing"
+	domain_user "go-gin-domain/internal/domain/user"
+
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("go-gin-domain/internal/application/usecase/user")
+
+// トレースのスパンを記録するためのラッパー
+type tracingUserUsecase struct {
+	next UserUsecase
+}
+
+// 各メソッドの呼び出しをスパンとして記録する
+func NewTracingUserUsecase(next UserUsecase) UserUsecase {
+	return &tracingUserUsecase{
+		next: next,
+	}
+}
+
+func (u *tracingUserUsecase) Create(ctx context.Context, lastName, firstName, email string) (*domain_user.User, error) {
+	ctx, span := tracer.Start(ctx, "UserUsecase.Create")
+	defer span.End()
+
+	result, err := u.next.Create(ctx, lastName, firstName, email)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (u *tracingUserUsecase) FindAll(ctx context.Context, params domain_user.FindAllParams) (*domain_user.FindAllResult, error) {
+	ctx, span := tracer.Start(ctx, "UserUsecase.FindAll")
+	defer span.End()
+
+	result, err := u.next.FindAll(ctx, params)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (u *tracingUserUsecase) FindByUID(ctx context.Context, uid string) (*domain_user.User, error) {
+	ctx, span := tracer.Start(ctx, "UserUsecase.FindByUID")
+	defer span.End()
+
+	result, err := u.next.FindByUID(ctx, uid)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (u *tracingUserUsecase) Update(ctx context.Context, uid, lastName, firstName, email string) (*domain_user.User, error) {
+	ctx, span := tracer.Start(ctx, "UserUsecase.Update")
+	defer span.End()
+
+	result, err := u.next.Update(ctx, uid, lastName, firstName, email)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (u *tracingUserUsecase) Delete(ctx context.Context, uid string) (*domain_user.User, error) {
+	ctx, span := tracer.Start(ctx, "UserUsecase.Delete")
+	defer span.End()
+
+	result, err := u.next.Delete(ctx, uid)
+	tracing.RecordError(span, err)
+	return result, err
+}
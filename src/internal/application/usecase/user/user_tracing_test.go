@@ -0,0 +1,154 @@
+//go:build unit
+
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockUser "go-gin-domain/internal/application/usecase/user/mock_user"
+	domain_user "go-gin-domain/internal/domain/user"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+)
+
+// 終了したスパンを記録する（パッケージのtracerは最初に設定したプロバイダーを使い続けるため、1度だけ設定する）
+var spanRecorder = func() *tracetest.SpanRecorder {
+	sr := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	return sr
+}()
+
+func TestTracingUserUsecase(t *testing.T) {
+	// ユースケースのモック
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockUserUsecase := mockUser.NewMockUserUsecase(ctrl)
+
+	u := NewTracingUserUsecase(mockUserUsecase)
+
+	// 呼び出し元のスパン
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	defer parent.End()
+
+	// 次の処理に渡されたコンテキストのスパン
+	var nextSpan trace.SpanContext
+
+	tests := []struct {
+		spanName string
+		// errを返すようにモック化して呼び出す
+		call func(err error) error
+	}{
+		{
+			spanName: "UserUsecase.Create",
+			call: func(err error) error {
+				mockUserUsecase.EXPECT().Create(gomock.Any(), "山田", "太郎", "taro@example.com").DoAndReturn(
+					func(ctx context.Context, _, _, _ string) (*domain_user.User, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = u.Create(ctx, "山田", "太郎", "taro@example.com")
+				return err
+			},
+		},
+		{
+			spanName: "UserUsecase.FindAll",
+			call: func(err error) error {
+				mockUserUsecase.EXPECT().FindAll(gomock.Any(), domain_user.FindAllParams{}).DoAndReturn(
+					func(ctx context.Context, _ domain_user.FindAllParams) (*domain_user.FindAllResult, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = u.FindAll(ctx, domain_user.FindAllParams{})
+				return err
+			},
+		},
+		{
+			spanName: "UserUsecase.FindByUID",
+			call: func(err error) error {
+				mockUserUsecase.EXPECT().FindByUID(gomock.Any(), "xxxx-xxxx-xxxx-0001").DoAndReturn(
+					func(ctx context.Context, _ string) (*domain_user.User, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = u.FindByUID(ctx, "xxxx-xxxx-xxxx-0001")
+				return err
+			},
+		},
+		{
+			spanName: "UserUsecase.Update",
+			call: func(err error) error {
+				mockUserUsecase.EXPECT().Update(gomock.Any(), "xxxx-xxxx-xxxx-0001", "山田", "太郎", "taro@example.com").DoAndReturn(
+					func(ctx context.Context, _, _, _, _ string) (*domain_user.User, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = u.Update(ctx, "xxxx-xxxx-xxxx-0001", "山田", "太郎", "taro@example.com")
+				return err
+			},
+		},
+		{
+			spanName: "UserUsecase.Delete",
+			call: func(err error) error {
+				mockUserUsecase.EXPECT().Delete(gomock.Any(), "xxxx-xxxx-xxxx-0001").DoAndReturn(
+					func(ctx context.Context, _ string) (*domain_user.User, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = u.Delete(ctx, "xxxx-xxxx-xxxx-0001")
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spanName+"のスパンを呼び出し元の子として記録すること", func(t *testing.T) {
+			spanRecorder.Reset()
+
+			// テストの実行
+			err := tt.call(nil)
+
+			// 検証
+			assert.NoError(t, err)
+			spans := spanRecorder.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			assert.Equal(t, tt.spanName, span.Name())
+			assert.Equal(t, parent.SpanContext().TraceID(), span.SpanContext().TraceID())
+			assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+			assert.Equal(t, span.SpanContext().SpanID(), nextSpan.SpanID())
+			assert.Equal(t, codes.Unset, span.Status().Code)
+		})
+
+		t.Run(tt.spanName+"でエラーの場合にスパンにエラーを記録すること", func(t *testing.T) {
+			spanRecorder.Reset()
+
+			// テストの実行
+			err := tt.call(errors.New("Internal Server Error"))
+
+			// 検証
+			assert.Error(t, err)
+			spans := spanRecorder.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			assert.Equal(t, tt.spanName, span.Name())
+			assert.Equal(t, codes.Error, span.Status().Code)
+			assert.Equal(t, "Internal Server Error", span.Status().Description)
+			require.Len(t, span.Events(), 1)
+			assert.Equal(t, "exception", span.Events()[0].Name)
+		})
+	}
+}
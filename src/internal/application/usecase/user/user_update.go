@@ -2,29 +2,47 @@ package user
 
 import (
 	"context"
-	"fmt"
 
+	"go-gin-domain/internal/domain/apperror"
+	"go-gin-domain/internal/domain/repository"
 	domain_user "go-gin-domain/internal/domain/user"
 )
 
 func (u *userUsecase) Update(ctx context.Context, uid, lastName, firstName, email string) (*domain_user.User, error) {
-	user, err := u.userRepo.FindByUID(ctx, u.db, uid)
-	if err != nil {
-		return nil, err
-	}
+	var updateUser *domain_user.User
 
-	// 対象ユーザーが存在しない場合はエラー
-	if user == nil {
-		msg := fmt.Sprintf("対象ユーザーが存在しません。: UID=%s", uid)
-		u.logger.Error(ctx, msg)
-		return nil, fmt.Errorf("%s", msg)
-	}
+	// 取得から更新までをトランザクション内で実行
+	err := u.txManager.Do(ctx, func(ctx context.Context, tx repository.DB) error {
+		user, err := u.userRepo.FindByUIDForUpdate(ctx, tx, uid)
+		if err != nil {
+			return err
+		}
+
+		// 対象ユーザーが存在しない場合はエラー
+		if user == nil {
+			u.logger.Warn(ctx, (&ErrUserNotFound{}).Error(), "uid", uid)
+			return &apperror.ErrNotFound{Err: &ErrUserNotFound{}}
+		}
+
+		// プロフィール更新
+		err = user.UpdateProfile(lastName, firstName, email)
+		if err != nil {
+			u.logger.Warn(ctx, "ユーザーの入力値が不正です。", "uid", uid, "error", err)
+			return err
+		}
+
+		// メールアドレスの重複チェック
+		if err := domain_user.EnsureUniqueEmail(ctx, tx, u.userRepo, user); err != nil {
+			u.logger.Warn(ctx, "ユーザーを更新できません。", "uid", uid, "error", err)
+			return err
+		}
 
-	// プロフィール更新
-	err = user.UpdateProfile(lastName, firstName, email)
+		updateUser, err = u.userRepo.Save(ctx, tx, user)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return u.userRepo.Save(ctx, u.db, user)
+	return updateUser, nil
 }
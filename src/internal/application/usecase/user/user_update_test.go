@@ -4,11 +4,15 @@ package user
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 	"time"
 
 	mockLogger "go-gin-domain/internal/application/usecase/logger/mock_logger"
+	mockTransaction "go-gin-domain/internal/application/usecase/transaction/mock_transaction"
+	"go-gin-domain/internal/domain/apperror"
+	"go-gin-domain/internal/domain/repository"
 	domain_user "go-gin-domain/internal/domain/user"
 	mockUser "go-gin-domain/internal/domain/user/mock_user_repository"
 
@@ -27,7 +31,7 @@ func init() {
 
 func TestUserUsecase_Update(t *testing.T) {
 	// DBのモック
-	mockDB := "dummy"
+	mockDB := &sql.DB{}
 
 	// リポジトリのモック
 	ctrl := gomock.NewController(t)
@@ -37,6 +41,14 @@ func TestUserUsecase_Update(t *testing.T) {
 	// ロガーのモック
 	mockLogger := mockLogger.NewMockLogger(ctrl)
 
+	// トランザクション管理のモック
+	mockTxManager := mockTransaction.NewMockTxManager(ctrl)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context, repository.DB) error) error {
+			return fn(ctx, mockDB)
+		},
+	).AnyTimes()
+
 	t.Run("正常終了すること", func(t *testing.T) {
 		// モック化
 		findUser := &domain_user.User{
@@ -49,7 +61,7 @@ func TestUserUsecase_Update(t *testing.T) {
 			UpdatedAt: time.Time{},
 			DeletedAt: nil,
 		}
-		mockRepo.EXPECT().FindByUID(gomock.Any(), gomock.Any(), gomock.Any()).Return(findUser, nil)
+		mockRepo.EXPECT().FindByUIDForUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(findUser, nil)
 
 		expectedUser := &domain_user.User{
 			ID:        1,
@@ -61,10 +73,11 @@ func TestUserUsecase_Update(t *testing.T) {
 			UpdatedAt: time.Now(),
 			DeletedAt: nil,
 		}
+		mockRepo.EXPECT().FindByEmail(gomock.Any(), gomock.Any(), "z.satou@example.com").Return(nil, nil)
 		mockRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedUser, nil)
 
 		// ユースケースのインスタンス化
-		userUsecase := NewUserUsecase(mockDB, mockRepo, mockLogger)
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
 
 		// テストの実行
 		ctx := context.Background()
@@ -92,10 +105,10 @@ func TestUserUsecase_Update(t *testing.T) {
 	t.Run("対象ユーザー取得でエラーの場合にエラーを返すこと", func(t *testing.T) {
 		// モック化
 		err := fmt.Errorf("Internal Server Error")
-		mockRepo.EXPECT().FindByUID(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, err)
+		mockRepo.EXPECT().FindByUIDForUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, err)
 
 		// ユースケースのインスタンス化
-		userUsecase := NewUserUsecase(mockDB, mockRepo, mockLogger)
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
 
 		// テストの実行
 		ctx := context.Background()
@@ -110,13 +123,48 @@ func TestUserUsecase_Update(t *testing.T) {
 		assert.Nil(t, user)
 	})
 
-	t.Run("対象ユーザーが存在しない場合にエラーを返すこと", func(t *testing.T) {
+	t.Run("対象ユーザーが存在しない場合にErrNotFoundを返すこと", func(t *testing.T) {
 		// モック化
-		mockRepo.EXPECT().FindByUID(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-		mockLogger.EXPECT().Error(gomock.Any(), gomock.Any()).Return()
+		mockRepo.EXPECT().FindByUIDForUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockLogger.EXPECT().Warn(gomock.Any(), "対象ユーザーが存在しません。", "uid", "xxxx-xxxx-xxxx-0001").Return()
 
 		// ユースケースのインスタンス化
-		userUsecase := NewUserUsecase(mockDB, mockRepo, mockLogger)
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		uid := "xxxx-xxxx-xxxx-0001"
+		lastName := "佐藤"
+		firstName := "二郎"
+		email := "z.satou@example.com"
+		user, err := userUsecase.Update(ctx, uid, lastName, firstName, email)
+
+		// 検証
+		var errNotFound *apperror.ErrNotFound
+		assert.ErrorAs(t, err, &errNotFound)
+		var errUserNotFound *ErrUserNotFound
+		assert.ErrorAs(t, err, &errUserNotFound)
+		assert.Nil(t, user)
+	})
+
+	t.Run("更新処理でエラーの場合にエラーを返すこと", func(t *testing.T) {
+		// モック化
+		findUser := &domain_user.User{
+			ID:        1,
+			UID:       "xxxx-xxxx-xxxx-0001",
+			LastName:  "田中",
+			FirstName: "太郎",
+			Email:     "t.tanaka@example.com",
+			CreatedAt: time.Time{},
+			UpdatedAt: time.Time{},
+			DeletedAt: nil,
+		}
+		mockRepo.EXPECT().FindByUIDForUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(findUser, nil)
+		mockRepo.EXPECT().FindByEmail(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("Internal Server Error"))
+
+		// ユースケースのインスタンス化
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
 
 		// テストの実行
 		ctx := context.Background()
@@ -143,10 +191,11 @@ func TestUserUsecase_Update(t *testing.T) {
 			UpdatedAt: time.Time{},
 			DeletedAt: nil,
 		}
-		mockRepo.EXPECT().FindByUID(gomock.Any(), gomock.Any(), gomock.Any()).Return(findUser, nil)
+		mockRepo.EXPECT().FindByUIDForUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(findUser, nil)
+		mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), "uid", "xxxx-xxxx-xxxx-0001", "error", gomock.Any()).Return()
 
 		// ユースケースのインスタンス化
-		userUsecase := NewUserUsecase(mockDB, mockRepo, mockLogger)
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
 
 		// テストの実行
 		ctx := context.Background()
@@ -157,7 +206,66 @@ func TestUserUsecase_Update(t *testing.T) {
 		user, err := userUsecase.Update(ctx, uid, lastName, firstName, email)
 
 		// 検証
-		assert.Error(t, err)
+		var errValidation *apperror.ErrValidation
+		assert.ErrorAs(t, err, &errValidation)
+		assert.Nil(t, user)
+	})
+
+	t.Run("メールアドレスが他のユーザーで登録済みの場合にErrEmailAlreadyExistsを返すこと", func(t *testing.T) {
+		// モック化
+		findUser := &domain_user.User{
+			ID:        1,
+			UID:       "xxxx-xxxx-xxxx-0001",
+			LastName:  "田中",
+			FirstName: "太郎",
+			Email:     "t.tanaka@example.com",
+		}
+		existingUser := &domain_user.User{
+			ID:    2,
+			UID:   "xxxx-xxxx-xxxx-0002",
+			Email: "z.satou@example.com",
+		}
+		mockRepo.EXPECT().FindByUIDForUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(findUser, nil)
+		mockRepo.EXPECT().FindByEmail(gomock.Any(), gomock.Any(), "z.satou@example.com").Return(existingUser, nil)
+		mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), "uid", "xxxx-xxxx-xxxx-0001", "error", gomock.Any()).Return()
+
+		// ユースケースのインスタンス化
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		user, err := userUsecase.Update(ctx, "xxxx-xxxx-xxxx-0001", "佐藤", "二郎", "z.satou@example.com")
+
+		// 検証
+		var errEmailAlreadyExists *domain_user.ErrEmailAlreadyExists
+		assert.ErrorAs(t, err, &errEmailAlreadyExists)
+		var errConflict *apperror.ErrConflict
+		assert.ErrorAs(t, err, &errConflict)
 		assert.Nil(t, user)
 	})
+
+	t.Run("メールアドレスが自身のものの場合は更新できること", func(t *testing.T) {
+		// モック化
+		findUser := &domain_user.User{
+			ID:        1,
+			UID:       "xxxx-xxxx-xxxx-0001",
+			LastName:  "田中",
+			FirstName: "太郎",
+			Email:     "t.tanaka@example.com",
+		}
+		mockRepo.EXPECT().FindByUIDForUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Return(findUser, nil)
+		mockRepo.EXPECT().FindByEmail(gomock.Any(), gomock.Any(), "t.tanaka@example.com").Return(findUser, nil)
+		mockRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(findUser, nil)
+
+		// ユースケースのインスタンス化
+		userUsecase := NewUserUsecase(mockDB, mockTxManager, mockRepo, mockLogger)
+
+		// テストの実行
+		ctx := context.Background()
+		user, err := userUsecase.Update(ctx, "xxxx-xxxx-xxxx-0001", "佐藤", "二郎", "t.tanaka@example.com")
+
+		// 検証
+		assert.NoError(t, err)
+		assert.NotNil(t, user)
+	})
 }
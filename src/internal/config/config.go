@@ -0,0 +1,526 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// アプリケーションの設定
+type Config struct {
+	// 実行環境（local、testing、productionなど）
+	Env        string           `yaml:"env"`
+	Server     ServerConfig     `yaml:"server"`
+	Repository string           `yaml:"repository"`
+	Memory     MemoryConfig     `yaml:"memory"`
+	DB         DBConfig         `yaml:"db"`
+	JWT        JWTConfig        `yaml:"jwt"`
+	Post       PostConfig       `yaml:"post"`
+	Migrations MigrationsConfig `yaml:"migrations"`
+	Tracing    TracingConfig    `yaml:"tracing"`
+	Log        LogConfig        `yaml:"log"`
+}
+
+// HTTPサーバーの設定
+type ServerConfig struct {
+	Port string `yaml:"port"`
+	// メトリクス（/metrics）を公開する管理用ポート（空の場合は起動しない）
+	AdminPort string `yaml:"admin_port"`
+	// リクエストヘッダーの読み込みのタイムアウト
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+	// リクエスト全体の読み込みのタイムアウト
+	ReadTimeout time.Duration `yaml:"read_timeout"`
+	// レスポンスの書き込みのタイムアウト
+	WriteTimeout time.Duration `yaml:"write_timeout"`
+	// Keep-Alive時の次のリクエストまでの待機時間
+	IdleTimeout time.Duration `yaml:"idle_timeout"`
+	// リクエストヘッダーの最大サイズ（バイト）
+	MaxHeaderBytes int `yaml:"max_header_bytes"`
+	// シャットダウン時に処理中のリクエストの完了を待つ時間
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	// シャットダウン開始後、レディネスを失敗させてから新規リクエストの受付を止めるまでの待機時間
+	ShutdownDelay time.Duration `yaml:"shutdown_delay"`
+	// リクエストヘッダーのX-Request-Idを引き継ぐか（falseの場合は常に生成する）
+	// クライアントが任意のIDを送れるため、X-Request-Idを付け直す信頼できるゲートウェイの背後でのみ有効にする
+	TrustRequestId bool `yaml:"trust_request_id"`
+}
+
+// 待ち受けるアドレス（例：:8080）
+func (c ServerConfig) Addr() string {
+	return fmt.Sprintf(":%s", c.Port)
+}
+
+// 管理用ポートで待ち受けるアドレス（例：:8081）
+func (c ServerConfig) AdminAddr() string {
+	return fmt.Sprintf(":%s", c.AdminPort)
+}
+
+// DBの設定
+type DBConfig struct {
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	User     string `yaml:"user"`
+	Password Secret `yaml:"password"`
+	Name     string `yaml:"name"`
+	SSLMode  string `yaml:"sslmode"`
+	// 起動時にマイグレーションを適用するか
+	MigrateOnStart bool `yaml:"migrate_on_start"`
+}
+
+// DB接続に必要な項目のチェック（マイグレーションのサブコマンドでも使用する）
+func (c DBConfig) Validate() error {
+	var errs []error
+	for _, item := range []struct{ key, value string }{
+		{"DB_HOST", c.Host},
+		{"DB_PORT", c.Port},
+		{"DB_USER", c.User},
+		{"DB_NAME", c.Name},
+	} {
+		if item.value == "" {
+			errs = append(errs, fmt.Errorf("%sが設定されていません。", item.key))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// JWT検証の設定
+type JWTConfig struct {
+	// HS256用の共有シークレット
+	HMACSecret Secret `yaml:"hmac_secret"`
+	// RS256/ES256用のJWKS（ファイルまたはエンドポイントのどちらかを指定）
+	JWKSFile string `yaml:"jwks_file"`
+	JWKSURL  string `yaml:"jwks_url"`
+	Issuer   string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+	// exp/nbfの判定で許容する時刻のずれ
+	Leeway time.Duration `yaml:"leeway"`
+}
+
+// インメモリのリポジトリの設定（REPOSITORY=memoryの場合のみ）
+type MemoryConfig struct {
+	// 起動時に登録するadminのユーザー（空の場合は登録しない）
+	// 認証用トークンのsubjectにUIDを設定すると、GET /usersなどadminのみ許可された操作を実行できる
+	AdminUID   string `yaml:"admin_uid"`
+	AdminEmail string `yaml:"admin_email"`
+}
+
+// Postの設定
+type PostConfig struct {
+	// 本文の最大文字数（0の場合はデフォルト値）
+	TextMaxLength int `yaml:"text_max_length"`
+}
+
+// マイグレーションの設定
+type MigrationsConfig struct {
+	// マイグレーションファイルの作成先（srcディレクトリからの相対パス）
+	Dir string `yaml:"dir"`
+}
+
+// トレース（OpenTelemetry）の設定
+type TracingConfig struct {
+	ServiceName string `yaml:"service_name"`
+	// スパンの出力先（none、stdout、otlp）
+	Exporter string `yaml:"exporter"`
+	// OTLPの送信先（例：http://localhost:4318）
+	Endpoint string `yaml:"endpoint"`
+	// サンプリングする割合（0〜1）
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+// ログの設定
+type LogConfig struct {
+	// 出力形式（text、json）
+	Format string `yaml:"format"`
+	// 出力する最低レベル（debug、info、warn、error）
+	Level string `yaml:"level"`
+	// 出力先（stdout、stderr、discard）
+	Output string `yaml:"output"`
+	// 呼び出し元のファイルと行番号を出力するか
+	AddSource bool `yaml:"add_source"`
+	// 値をマスクする属性のキー（PIIなど）
+	RedactKeys []string `yaml:"redact_keys"`
+	// アクセスログを出力しないパス（ステータスが500以上の場合は出力する）
+	AccessLogSkipPaths []string `yaml:"access_log_skip_paths"`
+	// 処理時間がこの値以上のリクエストのアクセスログをWarnで出力する（0の場合は無効）
+	SlowRequestThreshold time.Duration `yaml:"slow_request_threshold"`
+	// Info以下のログのサンプリング（同じメッセージを期間ごとに最初のInitial件は出力し、以降はThereafter件ごとに1件出力）
+	// Initialが0の場合はサンプリングしない
+	SamplingInitial    int           `yaml:"sampling_initial"`
+	SamplingThereafter int           `yaml:"sampling_thereafter"`
+	SamplingTick       time.Duration `yaml:"sampling_tick"`
+}
+
+// リポジトリの種類
+const (
+	RepositoryPostgres = "postgres"
+	RepositoryMemory   = "memory"
+)
+
+// デフォルト値
+func defaultConfig() *Config {
+	return &Config{
+		Env: "local",
+		Server: ServerConfig{
+			Port:              "8080",
+			AdminPort:         "8081",
+			ReadHeaderTimeout: 5 * time.Second,
+			ReadTimeout:       15 * time.Second,
+			WriteTimeout:      15 * time.Second,
+			IdleTimeout:       60 * time.Second,
+			MaxHeaderBytes:    1 << 20,
+			ShutdownTimeout:   30 * time.Second,
+		},
+		Repository: RepositoryPostgres,
+		Migrations: MigrationsConfig{
+			Dir: "internal/infrastructure/database/migrations",
+		},
+		Tracing: TracingConfig{
+			ServiceName: "go-gin-domain",
+			Exporter:    "none",
+			SampleRatio: 1,
+		},
+		Log: LogConfig{
+			Format:       "text",
+			Level:        "info",
+			Output:       "stdout",
+			RedactKeys:   []string{"email", "password", "token", "authorization"},
+			SamplingTick: time.Second,
+
+			AccessLogSkipPaths:   []string{"/healthz", "/readyz"},
+			SlowRequestThreshold: time.Second,
+		},
+	}
+}
+
+// カレントディレクトリの設定ファイルと環境変数から設定を読み込む
+func Load() (*Config, error) {
+	return load(".", os.LookupEnv)
+}
+
+// 設定の優先順位は、環境変数 > .env.{ENV} > .env > YAMLファイル（CONFIG_FILE） > デフォルト値
+func load(dir string, lookupEnv func(string) (string, bool)) (*Config, error) {
+	// .envファイルの読み込み（ファイルが無い場合はスキップ）
+	dotenv, err := readDotEnv(filepath.Join(dir, ".env"))
+	if err != nil {
+		return nil, err
+	}
+
+	// 環境変数、.envファイルの順で値を探す
+	files := []map[string]string{dotenv}
+	lookup := func(key string) (string, bool) {
+		if value, ok := lookupEnv(key); ok {
+			return value, true
+		}
+		for _, file := range files {
+			if value, ok := file[key]; ok {
+				return value, true
+			}
+		}
+		return "", false
+	}
+
+	// 環境ごとの.envファイル（例：.env.testing）を.envより優先する
+	if env, ok := lookup("ENV"); ok && env != "" {
+		envFile, err := readDotEnv(filepath.Join(dir, ".env."+env))
+		if err != nil {
+			return nil, err
+		}
+		files = []map[string]string{envFile, dotenv}
+	}
+
+	cfg := defaultConfig()
+
+	// YAMLファイル
+	if path, ok := lookup("CONFIG_FILE"); ok && path != "" {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		if err := readYAML(path, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	// 環境変数
+	l := &loader{lookup: lookup}
+	l.string("ENV", &cfg.Env)
+	l.string("PORT", &cfg.Server.Port)
+	l.string("ADMIN_PORT", &cfg.Server.AdminPort)
+	l.duration("SERVER_READ_HEADER_TIMEOUT", &cfg.Server.ReadHeaderTimeout)
+	l.duration("SERVER_READ_TIMEOUT", &cfg.Server.ReadTimeout)
+	l.duration("SERVER_WRITE_TIMEOUT", &cfg.Server.WriteTimeout)
+	l.duration("SERVER_IDLE_TIMEOUT", &cfg.Server.IdleTimeout)
+	l.int("SERVER_MAX_HEADER_BYTES", &cfg.Server.MaxHeaderBytes)
+	l.duration("SERVER_SHUTDOWN_TIMEOUT", &cfg.Server.ShutdownTimeout)
+	l.duration("SERVER_SHUTDOWN_DELAY", &cfg.Server.ShutdownDelay)
+	l.bool("SERVER_TRUST_REQUEST_ID", &cfg.Server.TrustRequestId)
+	l.string("REPOSITORY", &cfg.Repository)
+	l.string("MEMORY_ADMIN_UID", &cfg.Memory.AdminUID)
+	l.string("MEMORY_ADMIN_EMAIL", &cfg.Memory.AdminEmail)
+	l.string("DB_HOST", &cfg.DB.Host)
+	l.string("DB_PORT", &cfg.DB.Port)
+	l.string("DB_USER", &cfg.DB.User)
+	l.secret("DB_PASSWORD", &cfg.DB.Password)
+	l.string("DB_NAME", &cfg.DB.Name)
+	l.string("DB_SSLMODE", &cfg.DB.SSLMode)
+	l.bool("DB_MIGRATE_ON_START", &cfg.DB.MigrateOnStart)
+	l.secret("JWT_HMAC_SECRET", &cfg.JWT.HMACSecret)
+	l.string("JWT_JWKS_FILE", &cfg.JWT.JWKSFile)
+	l.string("JWT_JWKS_URL", &cfg.JWT.JWKSURL)
+	l.string("JWT_ISSUER", &cfg.JWT.Issuer)
+	l.string("JWT_AUDIENCE", &cfg.JWT.Audience)
+	l.duration("JWT_LEEWAY", &cfg.JWT.Leeway)
+	l.int("POST_TEXT_MAX_LENGTH", &cfg.Post.TextMaxLength)
+	l.string("MIGRATIONS_DIR", &cfg.Migrations.Dir)
+	l.string("OTEL_SERVICE_NAME", &cfg.Tracing.ServiceName)
+	l.string("OTEL_TRACES_EXPORTER", &cfg.Tracing.Exporter)
+	l.string("OTEL_EXPORTER_OTLP_ENDPOINT", &cfg.Tracing.Endpoint)
+	l.float("OTEL_TRACES_SAMPLER_ARG", &cfg.Tracing.SampleRatio)
+	l.string("LOG_FORMAT", &cfg.Log.Format)
+	l.string("LOG_LEVEL", &cfg.Log.Level)
+	l.string("LOG_OUTPUT", &cfg.Log.Output)
+	l.bool("LOG_ADD_SOURCE", &cfg.Log.AddSource)
+	l.strings("LOG_REDACT_KEYS", &cfg.Log.RedactKeys)
+	l.strings("ACCESS_LOG_SKIP_PATHS", &cfg.Log.AccessLogSkipPaths)
+	l.duration("SLOW_REQUEST_THRESHOLD", &cfg.Log.SlowRequestThreshold)
+	l.int("LOG_SAMPLING_INITIAL", &cfg.Log.SamplingInitial)
+	l.int("LOG_SAMPLING_THEREAFTER", &cfg.Log.SamplingThereafter)
+	l.duration("LOG_SAMPLING_TICK", &cfg.Log.SamplingTick)
+	if err := errors.Join(l.errs...); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// 必須項目と値の範囲をチェックする（全てのエラーをまとめて返す）
+func (c *Config) Validate() error {
+	var errs []error
+	required := func(key, value string) {
+		if value == "" {
+			errs = append(errs, fmt.Errorf("%sが設定されていません。", key))
+		}
+	}
+	positive := func(key string, value time.Duration) {
+		if value <= 0 {
+			errs = append(errs, fmt.Errorf("%sは0より大きい値を設定して下さい。: %s", key, value))
+		}
+	}
+
+	required("ENV", c.Env)
+	if !validPort(c.Server.Port) {
+		errs = append(errs, fmt.Errorf("PORTの値が不正です。: %s", c.Server.Port))
+	}
+	if c.Server.AdminPort != "" {
+		if !validPort(c.Server.AdminPort) {
+			errs = append(errs, fmt.Errorf("ADMIN_PORTの値が不正です。: %s", c.Server.AdminPort))
+		} else if c.Server.AdminPort == c.Server.Port {
+			errs = append(errs, fmt.Errorf("ADMIN_PORTはPORTと異なる値を設定して下さい。: %s", c.Server.AdminPort))
+		}
+	}
+	positive("SERVER_READ_HEADER_TIMEOUT", c.Server.ReadHeaderTimeout)
+	positive("SERVER_READ_TIMEOUT", c.Server.ReadTimeout)
+	positive("SERVER_WRITE_TIMEOUT", c.Server.WriteTimeout)
+	positive("SERVER_IDLE_TIMEOUT", c.Server.IdleTimeout)
+	positive("SERVER_SHUTDOWN_TIMEOUT", c.Server.ShutdownTimeout)
+	if c.Server.ShutdownDelay < 0 {
+		errs = append(errs, fmt.Errorf("SERVER_SHUTDOWN_DELAYは0以上を設定して下さい。: %s", c.Server.ShutdownDelay))
+	}
+	if c.Server.MaxHeaderBytes <= 0 {
+		errs = append(errs, fmt.Errorf("SERVER_MAX_HEADER_BYTESは1以上を設定して下さい。: %d", c.Server.MaxHeaderBytes))
+	}
+
+	switch c.Repository {
+	case RepositoryMemory:
+		if c.Memory.AdminUID != "" {
+			required("MEMORY_ADMIN_EMAIL", c.Memory.AdminEmail)
+		}
+	case RepositoryPostgres:
+		if err := c.DB.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+		if c.Memory.AdminUID != "" {
+			errs = append(errs, fmt.Errorf("MEMORY_ADMIN_UIDはREPOSITORYがmemoryの場合のみ設定できます。"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("REPOSITORYはpostgresまたはmemoryを設定して下さい。: %s", c.Repository))
+	}
+
+	if c.JWT.HMACSecret == "" && c.JWT.JWKSFile == "" && c.JWT.JWKSURL == "" {
+		errs = append(errs, fmt.Errorf("JWT_HMAC_SECRET、JWT_JWKS_FILE、JWT_JWKS_URLのいずれかを設定して下さい。"))
+	}
+	if c.JWT.JWKSFile != "" && c.JWT.JWKSURL != "" {
+		errs = append(errs, fmt.Errorf("JWT_JWKS_FILEとJWT_JWKS_URLはどちらか一方を設定して下さい。"))
+	}
+	if c.JWT.Leeway < 0 {
+		errs = append(errs, fmt.Errorf("JWT_LEEWAYは0以上を設定して下さい。: %s", c.JWT.Leeway))
+	}
+
+	if c.Post.TextMaxLength < 0 {
+		errs = append(errs, fmt.Errorf("POST_TEXT_MAX_LENGTHは1以上を設定して下さい。: %d", c.Post.TextMaxLength))
+	}
+
+	required("OTEL_SERVICE_NAME", c.Tracing.ServiceName)
+	switch c.Tracing.Exporter {
+	case "none", "stdout", "otlp":
+	default:
+		errs = append(errs, fmt.Errorf("OTEL_TRACES_EXPORTERはnone、stdout、otlpのいずれかを設定して下さい。: %s", c.Tracing.Exporter))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("OTEL_TRACES_SAMPLER_ARGは0以上1以下を設定して下さい。: %g", c.Tracing.SampleRatio))
+	}
+
+	switch c.Log.Format {
+	case "text", "json":
+	default:
+		errs = append(errs, fmt.Errorf("LOG_FORMATはtextまたはjsonを設定して下さい。: %s", c.Log.Format))
+	}
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Log.Level)); err != nil {
+		errs = append(errs, fmt.Errorf("LOG_LEVELはdebug、info、warn、errorのいずれかを設定して下さい。: %s", c.Log.Level))
+	}
+	switch c.Log.Output {
+	case "stdout", "stderr", "discard":
+	default:
+		errs = append(errs, fmt.Errorf("LOG_OUTPUTはstdout、stderr、discardのいずれかを設定して下さい。: %s", c.Log.Output))
+	}
+	if c.Log.SlowRequestThreshold < 0 {
+		errs = append(errs, fmt.Errorf("SLOW_REQUEST_THRESHOLDは0以上を設定して下さい。: %s", c.Log.SlowRequestThreshold))
+	}
+	if c.Log.SamplingInitial < 0 || c.Log.SamplingThereafter < 0 {
+		errs = append(errs, fmt.Errorf("LOG_SAMPLING_INITIALとLOG_SAMPLING_THEREAFTERは0以上を設定して下さい。"))
+	}
+	if c.Log.SamplingInitial > 0 && c.Log.SamplingTick <= 0 {
+		errs = append(errs, fmt.Errorf("LOG_SAMPLING_TICKは0より大きい値を設定して下さい。: %s", c.Log.SamplingTick))
+	}
+
+	return errors.Join(errs...)
+}
+
+// ポート番号の範囲（1〜65535）をチェックする
+func validPort(value string) bool {
+	port, err := strconv.Atoi(value)
+	return err == nil && port >= 1 && port <= 65535
+}
+
+// 設定内容の文字列（秘匿情報はマスクする）
+func (c *Config) String() string {
+	type plain Config
+	return fmt.Sprintf("%+v", plain(*c))
+}
+
+// .envファイルを読み込む（環境変数には設定しない）
+func readDotEnv(path string) (map[string]string, error) {
+	values, err := godotenv.Read(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%sの読み込みに失敗しました。: %w", path, err)
+	}
+	return values, nil
+}
+
+// YAMLファイルを読み込む（ファイルに無い項目は元の値のまま）
+func readYAML(path string, cfg *Config) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("設定ファイルの読み込みに失敗しました。: %w", err)
+	}
+	if err := yaml.Unmarshal(b, cfg); err != nil {
+		return fmt.Errorf("設定ファイルの形式が不正です。: %s: %w", path, err)
+	}
+	return nil
+}
+
+// 環境変数の値を型に合わせて変換する（未設定の場合は元の値のまま）
+type loader struct {
+	lookup func(string) (string, bool)
+	errs   []error
+}
+
+func (l *loader) string(key string, dst *string) {
+	if value, ok := l.lookup(key); ok {
+		*dst = value
+	}
+}
+
+func (l *loader) secret(key string, dst *Secret) {
+	if value, ok := l.lookup(key); ok {
+		*dst = Secret(value)
+	}
+}
+
+// カンマ区切りの値（空の場合は空のスライス）
+func (l *loader) strings(key string, dst *[]string) {
+	value, ok := l.lookup(key)
+	if !ok {
+		return
+	}
+	values := []string{}
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	*dst = values
+}
+
+func (l *loader) int(key string, dst *int) {
+	value, ok := l.lookup(key)
+	if !ok || value == "" {
+		return
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		l.errs = append(l.errs, fmt.Errorf("%sの値が不正です。: %s", key, value))
+		return
+	}
+	*dst = n
+}
+
+func (l *loader) float(key string, dst *float64) {
+	value, ok := l.lookup(key)
+	if !ok || value == "" {
+		return
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		l.errs = append(l.errs, fmt.Errorf("%sの値が不正です。: %s", key, value))
+		return
+	}
+	*dst = f
+}
+
+func (l *loader) bool(key string, dst *bool) {
+	value, ok := l.lookup(key)
+	if !ok || value == "" {
+		return
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		l.errs = append(l.errs, fmt.Errorf("%sの値が不正です。: %s", key, value))
+		return
+	}
+	*dst = b
+}
+
+func (l *loader) duration(key string, dst *time.Duration) {
+	value, ok := l.lookup(key)
+	if !ok || value == "" {
+		return
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		l.errs = append(l.errs, fmt.Errorf("%sの値が不正です。: %s", key, value))
+		return
+	}
+	*dst = d
+}
@@ -0,0 +1,174 @@
+//go:build unit
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// テスト用の環境変数
+func envFrom(values map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := values[key]
+		return value, ok
+	}
+}
+
+// テスト用のファイルを作成する
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("ファイルと環境変数が無い場合はデフォルト値", func(t *testing.T) {
+		cfg, err := load(t.TempDir(), envFrom(nil))
+
+		require.NoError(t, err)
+		assert.Equal(t, defaultConfig(), cfg)
+		// X-Request-Idはデフォルトでは引き継がない
+		assert.False(t, cfg.Server.TrustRequestId)
+	})
+
+	t.Run("環境変数 > .env.{ENV} > .env > YAMLファイルの順で優先すること", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, dir, "config.yml", `
+server:
+  port: "9000"
+  read_timeout: 20s
+repository: memory
+db:
+  host: yaml-host
+  user: yaml-user
+post:
+  text_max_length: 100
+`)
+		writeFile(t, dir, ".env", "CONFIG_FILE=config.yml\nENV=testing\nDB_HOST=dotenv-host\nDB_USER=dotenv-user\n")
+		writeFile(t, dir, ".env.testing", "DB_HOST=testing-host\nJWT_LEEWAY=30s\n")
+
+		cfg, err := load(dir, envFrom(map[string]string{"PORT": "8081"}))
+
+		require.NoError(t, err)
+		assert.Equal(t, "testing", cfg.Env)
+		assert.Equal(t, "8081", cfg.Server.Port)
+		assert.Equal(t, 20*time.Second, cfg.Server.ReadTimeout)
+		assert.Equal(t, 15*time.Second, cfg.Server.WriteTimeout)
+		assert.Equal(t, RepositoryMemory, cfg.Repository)
+		assert.Equal(t, "testing-host", cfg.DB.Host)
+		assert.Equal(t, "dotenv-user", cfg.DB.User)
+		assert.Equal(t, 30*time.Second, cfg.JWT.Leeway)
+		assert.Equal(t, 100, cfg.Post.TextMaxLength)
+	})
+
+	t.Run("値の型が不正な場合はエラーを返すこと", func(t *testing.T) {
+		cfg, err := load(t.TempDir(), envFrom(map[string]string{
+			"SERVER_READ_TIMEOUT":  "15",
+			"DB_MIGRATE_ON_START":  "yes",
+			"POST_TEXT_MAX_LENGTH": "abc",
+		}))
+
+		assert.Nil(t, cfg)
+		assert.EqualError(t, err, "SERVER_READ_TIMEOUTの値が不正です。: 15\nDB_MIGRATE_ON_STARTの値が不正です。: yes\nPOST_TEXT_MAX_LENGTHの値が不正です。: abc")
+	})
+
+	t.Run("YAMLファイルが存在しない場合はエラーを返すこと", func(t *testing.T) {
+		cfg, err := load(t.TempDir(), envFrom(map[string]string{"CONFIG_FILE": "missing.yml"}))
+
+		assert.Nil(t, cfg)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	// 正常な設定
+	validConfig := func() *Config {
+		cfg := defaultConfig()
+		cfg.Repository = RepositoryMemory
+		cfg.JWT.HMACSecret = "secret"
+		return cfg
+	}
+
+	t.Run("正常な設定の場合はエラーを返さないこと", func(t *testing.T) {
+		assert.NoError(t, validConfig().Validate())
+	})
+
+	t.Run("不正な項目のエラーをまとめて返すこと", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Server.Port = "0"
+		cfg.Server.ShutdownTimeout = 0
+		cfg.Repository = RepositoryPostgres
+		cfg.DB.Host = "db"
+		cfg.DB.Port = "5432"
+		cfg.JWT.HMACSecret = ""
+
+		err := cfg.Validate()
+
+		assert.EqualError(t, err, "PORTの値が不正です。: 0\nSERVER_SHUTDOWN_TIMEOUTは0より大きい値を設定して下さい。: 0s\nDB_USERが設定されていません。\nDB_NAMEが設定されていません。\nJWT_HMAC_SECRET、JWT_JWKS_FILE、JWT_JWKS_URLのいずれかを設定して下さい。")
+	})
+
+	t.Run("REPOSITORYが不正な場合はエラーを返すこと", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Repository = "mysql"
+
+		assert.EqualError(t, cfg.Validate(), "REPOSITORYはpostgresまたはmemoryを設定して下さい。: mysql")
+	})
+
+	t.Run("MEMORY_ADMIN_UIDを設定した場合はMEMORY_ADMIN_EMAILも必須とすること", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Memory.AdminUID = "0196f1c2-7a3b-7c4d-8e5f-000000000001"
+
+		assert.EqualError(t, cfg.Validate(), "MEMORY_ADMIN_EMAILが設定されていません。")
+
+		cfg.Memory.AdminEmail = "admin@example.com"
+		assert.NoError(t, cfg.Validate())
+	})
+
+	t.Run("REPOSITORYがmemory以外の場合はMEMORY_ADMIN_UIDを設定できないこと", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Repository = RepositoryPostgres
+		cfg.DB.Host = "db"
+		cfg.DB.Port = "5432"
+		cfg.DB.User = "pguser"
+		cfg.DB.Name = "pgdb"
+		cfg.Memory.AdminUID = "0196f1c2-7a3b-7c4d-8e5f-000000000001"
+		cfg.Memory.AdminEmail = "admin@example.com"
+
+		assert.EqualError(t, cfg.Validate(), "MEMORY_ADMIN_UIDはREPOSITORYがmemoryの場合のみ設定できます。")
+	})
+}
+
+func TestDBConfig_Validate(t *testing.T) {
+	t.Run("必須項目が設定されている場合はエラーを返さないこと", func(t *testing.T) {
+		db := DBConfig{Host: "db", Port: "5432", User: "pguser", Name: "pgdb"}
+
+		assert.NoError(t, db.Validate())
+	})
+
+	t.Run("必須項目が未設定の場合はエラーを返すこと", func(t *testing.T) {
+		db := DBConfig{Host: "db"}
+
+		assert.EqualError(t, db.Validate(), "DB_PORTが設定されていません。\nDB_USERが設定されていません。\nDB_NAMEが設定されていません。")
+	})
+}
+
+func TestConfig_String(t *testing.T) {
+	t.Run("秘匿情報をマスクすること", func(t *testing.T) {
+		cfg := defaultConfig()
+		cfg.DB.Password = "db-password"
+		cfg.JWT.HMACSecret = "jwt-secret"
+
+		for _, s := range []string{cfg.String(), fmt.Sprintf("%v", cfg), fmt.Sprintf("%+v", *cfg)} {
+			assert.NotContains(t, s, "db-password")
+			assert.NotContains(t, s, "jwt-secret")
+			assert.Contains(t, s, "[REDACTED]")
+		}
+		assert.Equal(t, "jwt-secret", cfg.JWT.HMACSecret.Value())
+	})
+}
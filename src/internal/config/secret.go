@@ -0,0 +1,34 @@
+package config
+
+import (
+	"log/slog"
+)
+
+// パスワードなどの秘匿情報（ログ出力や文字列変換の際はマスクする）
+type Secret string
+
+const redacted = "[REDACTED]"
+
+// 元の値を取得する
+func (s Secret) Value() string {
+	return string(s)
+}
+
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+func (s Secret) GoString() string {
+	return s.String()
+}
+
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
+
+func (s Secret) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
@@ -0,0 +1,102 @@
+package apperror
+
+import (
+	"strings"
+)
+
+// エラーの種類を表すカスタムエラー。
+// 各ドメインのエラーをラップし、プレゼンテーション層でHTTPステータスコードに変換する。
+// 元のエラーはUnwrapで取得できるため、errors.Asで個別のエラーも判定可能。
+
+// 対象が存在しない場合のエラー
+type ErrNotFound struct {
+	Err error
+}
+
+func (e *ErrNotFound) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ErrNotFound) Unwrap() error {
+	return e.Err
+}
+
+// 既存のデータと競合する場合のエラー
+type ErrConflict struct {
+	Err error
+}
+
+func (e *ErrConflict) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ErrConflict) Unwrap() error {
+	return e.Err
+}
+
+// 操作する権限が無い場合のエラー
+type ErrForbidden struct {
+	Err error
+}
+
+func (e *ErrForbidden) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ErrForbidden) Unwrap() error {
+	return e.Err
+}
+
+// リクエストの値を解釈できない場合のエラー（不正なカーソルなど）
+type ErrBadRequest struct {
+	Err error
+}
+
+func (e *ErrBadRequest) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ErrBadRequest) Unwrap() error {
+	return e.Err
+}
+
+// 項目ごとのエラー
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// 入力値が不正な場合のエラー（複数項目のエラーをまとめる）
+type ErrValidation struct {
+	Errors []*FieldError
+}
+
+// 1項目のバリデーションエラーを作成する
+func NewErrValidation(field string, err error) *ErrValidation {
+	return &ErrValidation{Errors: []*FieldError{{Field: field, Err: err}}}
+}
+
+func (e *ErrValidation) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, ", ")
+}
+
+// errors.Asで各項目のエラーを判定できるようにする
+func (e *ErrValidation) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
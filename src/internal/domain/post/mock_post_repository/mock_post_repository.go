@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domain/post/post_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/domain/post/post_repository.go -destination=./internal/domain/post/mock_post_repository/mock_post_repository.go
+//
+
+// Package mock_post is a generated GoMock package.
+package mock_post
+
+import (
+	context "context"
+	post "go-gin-domain/internal/domain/post"
+	repository "go-gin-domain/internal/domain/repository"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPostRepository is a mock of PostRepository interface.
+type MockPostRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPostRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPostRepositoryMockRecorder is the mock recorder for MockPostRepository.
+type MockPostRepositoryMockRecorder struct {
+	mock *MockPostRepository
+}
+
+// NewMockPostRepository creates a new mock instance.
+func NewMockPostRepository(ctrl *gomock.Controller) *MockPostRepository {
+	mock := &MockPostRepository{ctrl: ctrl}
+	mock.recorder = &MockPostRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPostRepository) EXPECT() *MockPostRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPostRepository) Create(ctx context.Context, db repository.DB, arg2 *post.Post) (*post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, db, arg2)
+	ret0, _ := ret[0].(*post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPostRepositoryMockRecorder) Create(ctx, db, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPostRepository)(nil).Create), ctx, db, arg2)
+}
+
+// FindAll mocks base method.
+func (m *MockPostRepository) FindAll(ctx context.Context, db repository.DB, params post.FindAllParams) (*post.FindAllResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, db, params)
+	ret0, _ := ret[0].(*post.FindAllResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPostRepositoryMockRecorder) FindAll(ctx, db, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPostRepository)(nil).FindAll), ctx, db, params)
+}
+
+// FindByID mocks base method.
+func (m *MockPostRepository) FindByID(ctx context.Context, db repository.DB, id int64) (*post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, db, id)
+	ret0, _ := ret[0].(*post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPostRepositoryMockRecorder) FindByID(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPostRepository)(nil).FindByID), ctx, db, id)
+}
+
+// FindByIDForUpdate mocks base method.
+func (m *MockPostRepository) FindByIDForUpdate(ctx context.Context, db repository.DB, id int64) (*post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDForUpdate", ctx, db, id)
+	ret0, _ := ret[0].(*post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDForUpdate indicates an expected call of FindByIDForUpdate.
+func (mr *MockPostRepositoryMockRecorder) FindByIDForUpdate(ctx, db, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDForUpdate", reflect.TypeOf((*MockPostRepository)(nil).FindByIDForUpdate), ctx, db, id)
+}
+
+// Save mocks base method.
+func (m *MockPostRepository) Save(ctx context.Context, db repository.DB, arg2 *post.Post) (*post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, db, arg2)
+	ret0, _ := ret[0].(*post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPostRepositoryMockRecorder) Save(ctx, db, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPostRepository)(nil).Save), ctx, db, arg2)
+}
@@ -1,30 +1,77 @@
 package post
 
+import (
+	"fmt"
+	"time"
+)
+
 // エンティティの定義
 type Post struct {
 	// フィールドはプライベートにし、値オブジェクト型を使用
+	id   int64
 	text Text
+	// 投稿者のUID（domain/user.UserのUID。投稿者が不明な場合は空）
+	authorUID string
+	createdAt time.Time
+	updatedAt time.Time
+	deletedAt *time.Time
 }
 
 // レスポンス用の構造体を定義
 type PostResponse struct {
+	ID   int64  `json:"id"`
 	Text string `json:"text"`
+	// 投稿者が不明な場合はnull
+	AuthorUID *string    `json:"author_uid"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
 }
 
 // コンストラクタ
-func NewPost(text string) (*Post, error) {
-	// 値オブジェクトを利用してtextをチェック
-	newText, err := NewText(text)
-	if err != nil {
-		return nil, err
+// textはチェック済みの値オブジェクトを受け取る
+func NewPost(authorUID string, text Text) (*Post, error) {
+	// 投稿者は必須
+	if authorUID == "" {
+		return nil, fmt.Errorf("投稿者のUIDが設定されていません。")
 	}
 
-	return &Post{text: newText}, nil
+	return &Post{text: text, authorUID: authorUID}, nil
 }
 
 // DBから復元するためのコンストラクタ（チェック処理無し）
-func ReconstitutePost(text string) *Post {
-	return &Post{text: ReconstituteText(text)}
+func ReconstitutePost(id int64, text, authorUID string, createdAt, updatedAt time.Time, deletedAt *time.Time) *Post {
+	return &Post{
+		id:        id,
+		text:      ReconstituteText(text),
+		authorUID: authorUID,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+		deletedAt: deletedAt,
+	}
+}
+
+// 投稿者かどうかを判定するメソッド（投稿者が不明な場合は誰も該当しない）
+func (p *Post) IsAuthor(uid string) bool {
+	return p.authorUID != "" && p.authorUID == uid
+}
+
+// textの更新（操作の可否はユースケースで投稿者と役割から判定する）
+func (p *Post) UpdateText(text Text) {
+	p.text = text
+	p.updatedAt = time.Now()
+}
+
+// 論理削除（操作の可否はユースケースで投稿者と役割から判定する）
+func (p *Post) Delete() {
+	now := time.Now()
+	p.updatedAt = now
+	p.deletedAt = &now
+}
+
+// idフィールドの値を返すメソッド
+func (p *Post) ID() int64 {
+	return p.id
 }
 
 // textフィールドの値を返すメソッド
@@ -32,9 +79,40 @@ func (p *Post) TextValue() string {
 	return p.text.Value()
 }
 
+// authorUIDフィールドの値を返すメソッド
+func (p *Post) AuthorUID() string {
+	return p.authorUID
+}
+
+// createdAtフィールドの値を返すメソッド
+func (p *Post) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+// updatedAtフィールドの値を返すメソッド
+func (p *Post) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// deletedAtフィールドの値を返すメソッド
+func (p *Post) DeletedAt() *time.Time {
+	return p.deletedAt
+}
+
 // DTO（Data Transfer Object）用の関数
 func ToResponse(p *Post) *PostResponse {
+	var authorUID *string
+	if p.AuthorUID() != "" {
+		uid := p.AuthorUID()
+		authorUID = &uid
+	}
+
 	return &PostResponse{
-		Text: p.TextValue(),
+		ID:        p.ID(),
+		Text:      p.TextValue(),
+		AuthorUID: authorUID,
+		CreatedAt: p.CreatedAt(),
+		UpdatedAt: p.UpdatedAt(),
+		DeletedAt: p.DeletedAt(),
 	}
 }
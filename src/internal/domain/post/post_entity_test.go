@@ -0,0 +1,76 @@
+//go:build unit
+
+package post
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPost(t *testing.T) {
+	t.Run("投稿者を設定して作成できること", func(t *testing.T) {
+		// 処理実行
+		post, err := NewPost("xxxx-xxxx-xxxx-0001", ReconstituteText("テスト"))
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, "xxxx-xxxx-xxxx-0001", post.AuthorUID())
+		assert.Equal(t, "テスト", post.TextValue())
+		assert.True(t, post.IsAuthor("xxxx-xxxx-xxxx-0001"))
+	})
+
+	t.Run("投稿者が未設定の場合にエラーを返すこと", func(t *testing.T) {
+		// 処理実行
+		post, err := NewPost("", ReconstituteText("テスト"))
+
+		// 検証
+		assert.Error(t, err)
+		assert.Nil(t, post)
+	})
+}
+
+func TestToResponse(t *testing.T) {
+	t.Run("投稿者が不明な場合にauthor_uidがnilになること", func(t *testing.T) {
+		// 処理実行
+		res := ToResponse(ReconstitutePost(1, "テスト", "", time.Time{}, time.Time{}, nil))
+
+		// 検証
+		assert.Nil(t, res.AuthorUID)
+	})
+
+	t.Run("投稿者のUIDを返すこと", func(t *testing.T) {
+		// 処理実行
+		res := ToResponse(ReconstitutePost(1, "テスト", "xxxx-xxxx-xxxx-0001", time.Time{}, time.Time{}, nil))
+
+		// 検証
+		assert.Equal(t, "xxxx-xxxx-xxxx-0001", *res.AuthorUID)
+	})
+}
+
+func TestPost_UpdateText(t *testing.T) {
+	t.Run("textを更新できること", func(t *testing.T) {
+		post := ReconstitutePost(1, "テスト", "xxxx-xxxx-xxxx-0001", time.Time{}, time.Time{}, nil)
+
+		// 処理実行
+		post.UpdateText(ReconstituteText("更新"))
+
+		// 検証
+		assert.Equal(t, "更新", post.TextValue())
+		assert.False(t, post.UpdatedAt().IsZero())
+	})
+}
+
+func TestPost_Delete(t *testing.T) {
+	t.Run("論理削除できること", func(t *testing.T) {
+		post := ReconstitutePost(1, "テスト", "xxxx-xxxx-xxxx-0001", time.Time{}, time.Time{}, nil)
+
+		// 処理実行
+		post.Delete()
+
+		// 検証
+		assert.NotNil(t, post.DeletedAt())
+		assert.Equal(t, *post.DeletedAt(), post.UpdatedAt())
+	})
+}
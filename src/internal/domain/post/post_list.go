@@ -0,0 +1,61 @@
+package post
+
+import (
+	"time"
+
+	"go-gin-domain/internal/domain/apperror"
+)
+
+// 取得件数
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// カスタムエラー用の構造体を定義
+type ErrInvalidPeriod struct{}
+
+func (e *ErrInvalidPeriod) Error() string {
+	return "created_beforeはcreated_afterより後の日時を指定して下さい。"
+}
+
+// 一覧取得の条件（並び順はID順で固定）
+type FindAllParams struct {
+	Limit int
+	// 前回の取得結果のNextCursor（空の場合は先頭から取得）
+	Cursor string
+	// 投稿者のUIDで絞り込み
+	AuthorUID string
+	// 作成日時で絞り込み（ゼロ値の場合は絞り込まない）
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+// 一覧取得の結果
+type FindAllResult struct {
+	Posts []*Post
+	// 次のページを取得するためのカーソル（次のページが無い場合は空）
+	NextCursor string
+	HasMore    bool
+}
+
+// 未指定または範囲外の項目にデフォルト値を設定する
+func (p FindAllParams) Normalize() FindAllParams {
+	if p.Limit <= 0 {
+		p.Limit = DefaultLimit
+	}
+	if p.Limit > MaxLimit {
+		p.Limit = MaxLimit
+	}
+
+	return p
+}
+
+// 作成日時の範囲が空になる条件はエラーとする
+func (p FindAllParams) Validate() error {
+	if !p.CreatedAfter.IsZero() && !p.CreatedBefore.IsZero() && !p.CreatedAfter.Before(p.CreatedBefore) {
+		return apperror.NewErrValidation("created_before", &ErrInvalidPeriod{})
+	}
+
+	return nil
+}
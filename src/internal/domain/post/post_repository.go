@@ -2,11 +2,16 @@ package post
 
 import (
 	"context"
+
+	"go-gin-domain/internal/domain/repository"
 )
 
 type PostRepository interface {
 	// dbはトランザクションを使うことを考慮し、パラメータとして渡せるようにする。
-	// 今回はdbはダミー設定を使うため、型はstringとしている。
-	Create(ctx context.Context, db string, post *Post) (*Post, error)
-	FindAll(ctx context.Context, db string) ([]*Post, error)
+	Create(ctx context.Context, db repository.DB, post *Post) (*Post, error)
+	FindAll(ctx context.Context, db repository.DB, params FindAllParams) (*FindAllResult, error)
+	FindByID(ctx context.Context, db repository.DB, id int64) (*Post, error)
+	// 更新前の取得用。トランザクション内で対象行をロックする。
+	FindByIDForUpdate(ctx context.Context, db repository.DB, id int64) (*Post, error)
+	Save(ctx context.Context, db repository.DB, post *Post) (*Post, error)
 }
@@ -1,10 +1,49 @@
 package post
 
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
+)
+
+// 本文の最大文字数のデフォルト値
+const DefaultTextMaxLength = 10
+
 // カスタムエラー用の構造体を定義
-type ErrInvalidLength struct{}
+type ErrInvalidLength struct {
+	Max int
+}
 
 func (e *ErrInvalidLength) Error() string {
-	return "文字数は10文字以下にして下さい。"
+	format, args := e.MessageFormat()
+	return fmt.Sprintf(format, args...)
+}
+
+// 多言語対応で翻訳する際の書式と引数
+func (e *ErrInvalidLength) MessageFormat() (string, []any) {
+	return "文字数は%d文字以下にして下さい。", []any{e.Max}
+}
+
+type ErrEmptyText struct{}
+
+func (e *ErrEmptyText) Error() string {
+	return "本文を入力して下さい。"
+}
+
+type ErrInvalidCharacter struct{}
+
+func (e *ErrInvalidCharacter) Error() string {
+	return "本文に使用できない文字が含まれています。"
+}
+
+// 本文のチェック条件
+type TextRule struct {
+	// 最大文字数（書記素クラスタ単位。0以下の場合はデフォルト値）
+	MaxLength int
 }
 
 // 値オブジェクトの定義
@@ -12,11 +51,40 @@ type Text struct {
 	value string
 }
 
-// コンストラクタ
+// デフォルトのチェック条件で作成するコンストラクタ
 func NewText(value string) (Text, error) {
-	// 文字数チェック
-	if len(value) > 10 {
-		return Text{}, &ErrInvalidLength{}
+	return TextRule{}.NewText(value)
+}
+
+// コンストラクタ
+func (r TextRule) NewText(value string) (Text, error) {
+	// 不正なUTF-8のバイト列は正規化前に拒否する
+	if !utf8.ValidString(value) {
+		return Text{}, &ErrInvalidCharacter{}
+	}
+
+	// NFCで正規化し、前後の空白を除去
+	value = strings.TrimSpace(norm.NFC.String(value))
+
+	// 必須チェック
+	if value == "" {
+		return Text{}, &ErrEmptyText{}
+	}
+
+	// 制御文字のチェック（改行とタブは許可）
+	for _, r := range value {
+		if unicode.IsControl(r) && r != '\n' && r != '\t' {
+			return Text{}, &ErrInvalidCharacter{}
+		}
+	}
+
+	// 文字数チェック（絵文字や結合文字を1文字として数える）
+	maxLength := r.MaxLength
+	if maxLength <= 0 {
+		maxLength = DefaultTextMaxLength
+	}
+	if uniseg.GraphemeClusterCount(value) > maxLength {
+		return Text{}, &ErrInvalidLength{Max: maxLength}
 	}
 
 	return Text{value: value}, nil
@@ -0,0 +1,97 @@
+//go:build unit
+
+package post
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewText(t *testing.T) {
+	t.Run("日本語10文字の場合に作成できること", func(t *testing.T) {
+		// 処理実行
+		text, err := NewText("あいうえおかきくけこ")
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, "あいうえおかきくけこ", text.Value())
+	})
+
+	t.Run("絵文字や結合文字を1文字として数えること", func(t *testing.T) {
+		// 家族の絵文字（ZWJ結合）と国旗はそれぞれ1文字
+		value := strings.Repeat("👨‍👩‍👧", 5) + strings.Repeat("🇯🇵", 5)
+
+		// 処理実行
+		text, err := NewText(value)
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, value, text.Value())
+	})
+
+	t.Run("NFCで正規化し前後の空白を除去すること", func(t *testing.T) {
+		// 「か」+ 結合用濁点
+		text, err := NewText("  \u304b\u3099\n")
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, "\u304c", text.Value())
+	})
+
+	t.Run("11文字の場合にErrInvalidLengthを返すこと", func(t *testing.T) {
+		// 処理実行
+		_, err := NewText("あいうえおかきくけこさ")
+
+		// 検証
+		var errInvalidLength *ErrInvalidLength
+		assert.ErrorAs(t, err, &errInvalidLength)
+		assert.Equal(t, "文字数は10文字以下にして下さい。", err.Error())
+	})
+
+	t.Run("最大文字数を変更できること", func(t *testing.T) {
+		rule := TextRule{MaxLength: 3}
+
+		// 処理実行
+		_, okErr := rule.NewText("あいう")
+		_, err := rule.NewText("あいうえ")
+
+		// 検証
+		assert.NoError(t, okErr)
+		var errInvalidLength *ErrInvalidLength
+		assert.ErrorAs(t, err, &errInvalidLength)
+		assert.Equal(t, 3, errInvalidLength.Max)
+	})
+
+	t.Run("空文字または空白のみの場合にErrEmptyTextを返すこと", func(t *testing.T) {
+		for _, value := range []string{"", " \n\t", "　"} {
+			// 処理実行
+			_, err := NewText(value)
+
+			// 検証
+			var errEmptyText *ErrEmptyText
+			assert.ErrorAs(t, err, &errEmptyText, value)
+		}
+	})
+
+	t.Run("制御文字を含む場合にErrInvalidCharacterを返すこと", func(t *testing.T) {
+		for _, value := range []string{"a\x00b", "a\x1bb", "a\u0085b", "a\xffb"} {
+			// 処理実行
+			_, err := NewText(value)
+
+			// 検証
+			var errInvalidCharacter *ErrInvalidCharacter
+			assert.ErrorAs(t, err, &errInvalidCharacter, value)
+		}
+	})
+
+	t.Run("改行とタブは許可すること", func(t *testing.T) {
+		// 処理実行
+		text, err := NewText("a\nb\tc")
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, "a\nb\tc", text.Value())
+	})
+}
@@ -0,0 +1,6 @@
+package repository
+
+// リポジトリにパラメータとして渡すDB接続のハンドル。
+// 実体（*sql.DBや*sql.Txなど）はインフラ層のリポジトリのみが扱い、ドメイン層およびアプリケーション層は中身に依存しない。
+// トランザクション内外で同じリポジトリを利用できるよう、TxManagerから渡されたハンドルをそのままリポジトリに渡す。
+type DB any
@@ -0,0 +1,8 @@
+package repository
+
+// カスタムエラー用の構造体を定義
+type ErrInvalidCursor struct{}
+
+func (e *ErrInvalidCursor) Error() string {
+	return "カーソルの値が不正です。"
+}
@@ -11,6 +11,7 @@ package mock_user
 
 import (
 	context "context"
+	repository "go-gin-domain/internal/domain/repository"
 	user "go-gin-domain/internal/domain/user"
 	reflect "reflect"
 
@@ -42,7 +43,7 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockUserRepository) Create(ctx context.Context, db string, arg2 *user.User) (*user.User, error) {
+func (m *MockUserRepository) Create(ctx context.Context, db repository.DB, arg2 *user.User) (*user.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", ctx, db, arg2)
 	ret0, _ := ret[0].(*user.User)
@@ -57,22 +58,37 @@ func (mr *MockUserRepositoryMockRecorder) Create(ctx, db, arg2 any) *gomock.Call
 }
 
 // FindAll mocks base method.
-func (m *MockUserRepository) FindAll(ctx context.Context, db string) ([]*user.User, error) {
+func (m *MockUserRepository) FindAll(ctx context.Context, db repository.DB, params user.FindAllParams) (*user.FindAllResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx, db)
-	ret0, _ := ret[0].([]*user.User)
+	ret := m.ctrl.Call(m, "FindAll", ctx, db, params)
+	ret0, _ := ret[0].(*user.FindAllResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockUserRepositoryMockRecorder) FindAll(ctx, db any) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) FindAll(ctx, db, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockUserRepository)(nil).FindAll), ctx, db)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockUserRepository)(nil).FindAll), ctx, db, params)
+}
+
+// FindByEmail mocks base method.
+func (m *MockUserRepository) FindByEmail(ctx context.Context, db repository.DB, email string) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmail", ctx, db, email)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmail indicates an expected call of FindByEmail.
+func (mr *MockUserRepositoryMockRecorder) FindByEmail(ctx, db, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockUserRepository)(nil).FindByEmail), ctx, db, email)
 }
 
 // FindByUID mocks base method.
-func (m *MockUserRepository) FindByUID(ctx context.Context, db, uid string) (*user.User, error) {
+func (m *MockUserRepository) FindByUID(ctx context.Context, db repository.DB, uid string) (*user.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindByUID", ctx, db, uid)
 	ret0, _ := ret[0].(*user.User)
@@ -86,8 +102,23 @@ func (mr *MockUserRepositoryMockRecorder) FindByUID(ctx, db, uid any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUID", reflect.TypeOf((*MockUserRepository)(nil).FindByUID), ctx, db, uid)
 }
 
+// FindByUIDForUpdate mocks base method.
+func (m *MockUserRepository) FindByUIDForUpdate(ctx context.Context, db repository.DB, uid string) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUIDForUpdate", ctx, db, uid)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUIDForUpdate indicates an expected call of FindByUIDForUpdate.
+func (mr *MockUserRepositoryMockRecorder) FindByUIDForUpdate(ctx, db, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUIDForUpdate", reflect.TypeOf((*MockUserRepository)(nil).FindByUIDForUpdate), ctx, db, uid)
+}
+
 // Save mocks base method.
-func (m *MockUserRepository) Save(ctx context.Context, db string, arg2 *user.User) (*user.User, error) {
+func (m *MockUserRepository) Save(ctx context.Context, db repository.DB, arg2 *user.User) (*user.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Save", ctx, db, arg2)
 	ret0, _ := ret[0].(*user.User)
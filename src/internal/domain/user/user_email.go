@@ -0,0 +1,48 @@
+package user
+
+import (
+	"net/mail"
+	"strings"
+)
+
+// メールアドレスの最大文字数（RFC 5321）
+const (
+	maxEmailLength      = 254
+	maxEmailLocalLength = 64
+)
+
+// 値オブジェクトの定義
+type Email struct {
+	value string
+}
+
+// コンストラクタ（前後の空白を除去し、小文字に正規化する）
+func NewEmail(value string) (Email, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return Email{}, &ErrEmptyEmail{}
+	}
+	if len(value) > maxEmailLength {
+		return Email{}, &ErrInvalidEmail{}
+	}
+
+	// RFC 5322の形式チェック（表示名付きの形式は不可）
+	addr, err := mail.ParseAddress(value)
+	if err != nil || addr.Address != value {
+		return Email{}, &ErrInvalidEmail{}
+	}
+
+	// ドメインはドットを含むこと（例：user@localhostは不可）
+	at := strings.LastIndex(value, "@")
+	local, domain := value[:at], value[at+1:]
+	if len(local) > maxEmailLocalLength || !strings.Contains(domain, ".") || strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") {
+		return Email{}, &ErrInvalidEmail{}
+	}
+
+	return Email{value: value}, nil
+}
+
+// 値を返すメソッド
+func (e Email) Value() string {
+	return e.value
+}
@@ -0,0 +1,82 @@
+package user
+
+import (
+	"fmt"
+
+	"go-gin-domain/internal/domain/apperror"
+)
+
+// カスタムエラー用の構造体を定義
+type ErrEmptyName struct{}
+
+func (e *ErrEmptyName) Error() string {
+	return "名前を入力して下さい。"
+}
+
+type ErrInvalidNameLength struct {
+	Max int
+}
+
+func (e *ErrInvalidNameLength) Error() string {
+	format, args := e.MessageFormat()
+	return fmt.Sprintf(format, args...)
+}
+
+// 多言語対応で翻訳する際の書式と引数
+func (e *ErrInvalidNameLength) MessageFormat() (string, []any) {
+	return "名前は%d文字以下にして下さい。", []any{e.Max}
+}
+
+type ErrInvalidNameCharacter struct{}
+
+func (e *ErrInvalidNameCharacter) Error() string {
+	return "名前に使用できない文字が含まれています。"
+}
+
+type ErrEmptyEmail struct{}
+
+func (e *ErrEmptyEmail) Error() string {
+	return "メールアドレスを入力して下さい。"
+}
+
+type ErrInvalidEmail struct{}
+
+func (e *ErrInvalidEmail) Error() string {
+	return "メールアドレスの形式が不正です。"
+}
+
+type ErrEmailAlreadyExists struct{}
+
+func (e *ErrEmailAlreadyExists) Error() string {
+	return "このメールアドレスは既に登録されています。"
+}
+
+// メールアドレスの重複エラーを競合エラーとして作成する
+func NewErrEmailAlreadyExists() error {
+	return &apperror.ErrConflict{Err: &ErrEmailAlreadyExists{}}
+}
+
+type ErrInvalidUID struct{}
+
+func (e *ErrInvalidUID) Error() string {
+	return "UIDの形式が不正です。"
+}
+
+// 項目ごとのチェック結果をまとめる
+type validator struct {
+	errors []*apperror.FieldError
+}
+
+func (v *validator) check(field string, err error) {
+	if err != nil {
+		v.errors = append(v.errors, &apperror.FieldError{Field: field, Err: err})
+	}
+}
+
+// エラーが無い場合はnilを返す
+func (v *validator) err() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return &apperror.ErrValidation{Errors: v.errors}
+}
@@ -0,0 +1,71 @@
+package user
+
+// 一覧取得の並び替え項目
+type SortField string
+
+const (
+	SortByCreatedAt SortField = "created_at"
+	SortByLastName  SortField = "last_name"
+	SortByEmail     SortField = "email"
+)
+
+// 並び順
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// 取得件数
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// 一覧取得の条件
+type FindAllParams struct {
+	Limit int
+	// 前回の取得結果のNextCursor（空の場合は先頭から取得）
+	Cursor    string
+	SortField SortField
+	SortOrder SortOrder
+	// emailの前方一致
+	EmailPrefix string
+	// last_nameまたはfirst_nameの部分一致
+	NameContains string
+	// 論理削除済みのユーザーを含めるか
+	IncludeDeleted bool
+}
+
+// 一覧取得の結果
+type FindAllResult struct {
+	Users []*User
+	// 次のページを取得するためのカーソル（次のページが無い場合は空）
+	NextCursor string
+	HasMore    bool
+}
+
+// 未指定または範囲外の項目にデフォルト値を設定する
+func (p FindAllParams) Normalize() FindAllParams {
+	if p.Limit <= 0 {
+		p.Limit = DefaultLimit
+	}
+	if p.Limit > MaxLimit {
+		p.Limit = MaxLimit
+	}
+
+	switch p.SortField {
+	case SortByCreatedAt, SortByLastName, SortByEmail:
+	default:
+		p.SortField = SortByCreatedAt
+	}
+
+	switch p.SortOrder {
+	case SortAsc, SortDesc:
+	default:
+		p.SortOrder = SortAsc
+	}
+
+	return p
+}
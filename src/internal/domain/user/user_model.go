@@ -1,8 +1,6 @@
 package user
 
 import (
-	"fmt"
-	"strings"
 	"time"
 )
 
@@ -12,62 +10,70 @@ type User struct {
 	LastName  string     `json:"last_name"`
 	FirstName string     `json:"first_name"`
 	Email     string     `json:"email"`
+	Role      Role       `json:"role"`
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
 	DeletedAt *time.Time `json:"deleted_at"`
 }
 
-func NewUser(uid, lastName, firstName, email string) *User {
+// 値オブジェクトを利用して各項目をチェックし、正規化した値を設定する
+// （役割はmemberで作成する）
+func NewUser(uid, lastName, firstName, email string) (*User, error) {
+	var v validator
+	newUID, err := NewUID(uid)
+	v.check("uid", err)
+	newLastName, err := NewPersonName(lastName)
+	v.check("last_name", err)
+	newFirstName, err := NewPersonName(firstName)
+	v.check("first_name", err)
+	newEmail, err := NewEmail(email)
+	v.check("email", err)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
 	return &User{
 		ID:        0,
-		UID:       uid,
-		LastName:  lastName,
-		FirstName: firstName,
-		Email:     email,
+		UID:       newUID.Value(),
+		LastName:  newLastName.Value(),
+		FirstName: newFirstName.Value(),
+		Email:     newEmail.Value(),
+		Role:      RoleMember,
 		CreatedAt: time.Time{},
 		UpdatedAt: time.Time{},
 		DeletedAt: nil,
-	}
+	}, nil
 }
 
 // プロフィール更新
 func (u *User) UpdateProfile(lastName, firstName, email string) error {
-	// パラメータチェック
-	var errMsg []string
-	if lastName == "" {
-		errMsg = append(errMsg, "last_nameは必須です。")
-	}
-	if firstName == "" {
-		errMsg = append(errMsg, "first_nameは必須です。")
-	}
-	if email == "" {
-		errMsg = append(errMsg, "emailは必須です。")
-	}
-	if len(errMsg) > 0 {
-		msg := fmt.Sprintf("バリデーションエラー: %s", strings.Join(errMsg, ", "))
-		return fmt.Errorf("%s", msg)
+	// 値オブジェクトを利用してパラメータチェック
+	var v validator
+	newLastName, err := NewPersonName(lastName)
+	v.check("last_name", err)
+	newFirstName, err := NewPersonName(firstName)
+	v.check("first_name", err)
+	newEmail, err := NewEmail(email)
+	v.check("email", err)
+	if err := v.err(); err != nil {
+		return err
 	}
 
 	// 更新
-	u.LastName = lastName
-	u.FirstName = firstName
-	u.Email = email
+	u.LastName = newLastName.Value()
+	u.FirstName = newFirstName.Value()
+	u.Email = newEmail.Value()
 	u.UpdatedAt = time.Now()
 
 	return nil
 }
 
 // 論理削除設定
+// （メールアドレスの一意制約は論理削除済みのユーザーを除くため、emailは変更しない）
 func (u *User) SetDelete() {
-	// 現在の日時を文字列で取得
 	date := time.Now()
-	dateString := date.Format("2006-01-02 15:04:05")
-
-	// 更新用のemailの値を設定
-	updateEmail := u.Email + dateString
 
 	// 更新
-	u.Email = updateEmail
 	u.UpdatedAt = date
 	u.DeletedAt = &date
 }
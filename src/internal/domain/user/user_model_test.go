@@ -6,30 +6,66 @@ import (
 	"testing"
 	"time"
 
+	"go-gin-domain/internal/domain/apperror"
+
 	"github.com/stretchr/testify/assert"
 )
 
 func TestNewUser(t *testing.T) {
 	t.Run("新規ユーザー作成", func(t *testing.T) {
-		uid := "xxxx-xxxx-xxxx-0001"
+		uid := "0196f1c2-7a3b-7c4d-8e5f-0a1b2c3d4e5f"
 		lastName := "田中"
 		firstName := "太郎"
 		email := "t.tanaka@example.com"
 
 		// 処理実行
-		user := NewUser(uid, lastName, firstName, email)
+		user, err := NewUser(uid, lastName, firstName, email)
 
 		// 検証
+		assert.NoError(t, err)
 		assert.NotNil(t, user)
 		assert.Equal(t, user.ID, int64(0))
 		assert.Equal(t, uid, user.UID)
 		assert.Equal(t, lastName, user.LastName)
 		assert.Equal(t, firstName, user.FirstName)
 		assert.Equal(t, email, user.Email)
+		assert.Equal(t, RoleMember, user.Role)
 		assert.True(t, user.CreatedAt.IsZero())
 		assert.True(t, user.UpdatedAt.IsZero())
 		assert.Nil(t, user.DeletedAt)
 	})
+
+	t.Run("各項目を正規化すること", func(t *testing.T) {
+		// 処理実行
+		user, err := NewUser("0196F1C2-7A3B-7C4D-8E5F-0A1B2C3D4E5F", " 田中 ", "太郎", " T.Tanaka@Example.COM ")
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, "0196f1c2-7a3b-7c4d-8e5f-0a1b2c3d4e5f", user.UID)
+		assert.Equal(t, "田中", user.LastName)
+		assert.Equal(t, "t.tanaka@example.com", user.Email)
+	})
+
+	t.Run("不正な項目がある場合に項目ごとのエラーを返すこと", func(t *testing.T) {
+		// 処理実行
+		user, err := NewUser("xxxx-xxxx-xxxx-0001", "田中1", "太郎", "t.tanaka")
+
+		// 検証
+		assert.Nil(t, user)
+		var errValidation *apperror.ErrValidation
+		assert.ErrorAs(t, err, &errValidation)
+		assert.Len(t, errValidation.Errors, 3)
+		assert.Equal(t, "uid", errValidation.Errors[0].Field)
+		assert.Equal(t, "last_name", errValidation.Errors[1].Field)
+		assert.Equal(t, "email", errValidation.Errors[2].Field)
+
+		var errInvalidUID *ErrInvalidUID
+		var errInvalidNameCharacter *ErrInvalidNameCharacter
+		var errInvalidEmail *ErrInvalidEmail
+		assert.ErrorAs(t, err, &errInvalidUID)
+		assert.ErrorAs(t, err, &errInvalidNameCharacter)
+		assert.ErrorAs(t, err, &errInvalidEmail)
+	})
 }
 
 func TestUser_UpdateProfile(t *testing.T) {
@@ -77,7 +113,7 @@ func TestUser_UpdateProfile(t *testing.T) {
 
 		// 検証
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "last_nameは必須です。")
+		assert.Contains(t, err.Error(), "last_name: 名前を入力して下さい。")
 	})
 
 	t.Run("プロフィール更新処理でfirst_nameが空の場合エラー", func(t *testing.T) {
@@ -92,7 +128,7 @@ func TestUser_UpdateProfile(t *testing.T) {
 
 		// 検証
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "first_nameは必須です。")
+		assert.Contains(t, err.Error(), "first_name: 名前を入力して下さい。")
 	})
 
 	t.Run("プロフィール更新処理でemailが空の場合エラー", func(t *testing.T) {
@@ -107,7 +143,7 @@ func TestUser_UpdateProfile(t *testing.T) {
 
 		// 検証
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "emailは必須です。")
+		assert.Contains(t, err.Error(), "email: メールアドレスを入力して下さい。")
 	})
 
 	t.Run("プロフィール更新処理で複数項目が空の場合エラー", func(t *testing.T) {
@@ -118,9 +154,9 @@ func TestUser_UpdateProfile(t *testing.T) {
 
 		// 検証
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "last_nameは必須です。")
-		assert.Contains(t, err.Error(), "first_nameは必須です。")
-		assert.Contains(t, err.Error(), "emailは必須です。")
+		assert.Contains(t, err.Error(), "last_name: 名前を入力して下さい。")
+		assert.Contains(t, err.Error(), "first_name: 名前を入力して下さい。")
+		assert.Contains(t, err.Error(), "email: メールアドレスを入力して下さい。")
 	})
 }
 
@@ -143,7 +179,7 @@ func TestUser_SetDelete(t *testing.T) {
 		user.SetDelete()
 
 		// 検証
-		assert.NotEqual(t, initialEmail, user.Email)
+		assert.Equal(t, initialEmail, user.Email)
 		assert.True(t, user.UpdatedAt.After(initialUpdatedAt))
 		assert.NotNil(t, user.DeletedAt)
 		assert.Equal(t, user.UpdatedAt, *user.DeletedAt)
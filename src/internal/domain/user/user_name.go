@@ -0,0 +1,58 @@
+package user
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
+)
+
+// 名前（姓または名）の最大文字数
+const MaxPersonNameLength = 50
+
+// 値オブジェクトの定義
+type PersonName struct {
+	value string
+}
+
+// コンストラクタ（NFCで正規化し、前後の空白を除去する）
+func NewPersonName(value string) (PersonName, error) {
+	value = strings.TrimSpace(norm.NFC.String(value))
+	if value == "" {
+		return PersonName{}, &ErrEmptyName{}
+	}
+
+	// 文字数チェック（書記素クラスタ単位）
+	if uniseg.GraphemeClusterCount(value) > MaxPersonNameLength {
+		return PersonName{}, &ErrInvalidNameLength{Max: MaxPersonNameLength}
+	}
+
+	// 文字種チェック（文字、結合文字、空白および一部の記号のみ許可）
+	for _, r := range value {
+		if !isNameRune(r) {
+			return PersonName{}, &ErrInvalidNameCharacter{}
+		}
+	}
+
+	return PersonName{value: value}, nil
+}
+
+func isNameRune(r rune) bool {
+	if unicode.IsLetter(r) || unicode.IsMark(r) {
+		return true
+	}
+
+	switch r {
+	// 空白（全角を含む）、ハイフン、アポストロフィ、ピリオド、中黒
+	case ' ', '　', '-', '\'', '’', '.', '・':
+		return true
+	}
+
+	return false
+}
+
+// 値を返すメソッド
+func (n PersonName) Value() string {
+	return n.value
+}
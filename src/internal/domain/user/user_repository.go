@@ -2,13 +2,21 @@ package user
 
 import (
 	"context"
+
+	"go-gin-domain/internal/domain/repository"
 )
 
 type UserRepository interface {
 	// dbはトランザクションを使うことを考慮し、パラメータとして渡せるようにする。
-	// 今回はdbはダミー設定を使うため、型はstringとしている。
-	Create(ctx context.Context, db string, user *User) (*User, error)
-	FindAll(ctx context.Context, db string) ([]*User, error)
-	FindByUID(ctx context.Context, db string, uid string) (*User, error)
-	Save(ctx context.Context, db string, user *User) (*User, error)
+	// メールアドレスが有効なユーザーと重複する場合はErrEmailAlreadyExists（ErrConflictでラップ）を返す
+	Create(ctx context.Context, db repository.DB, user *User) (*User, error)
+	FindAll(ctx context.Context, db repository.DB, params FindAllParams) (*FindAllResult, error)
+	FindByUID(ctx context.Context, db repository.DB, uid string) (*User, error)
+	// 論理削除済みのユーザーは対象外
+	FindByEmail(ctx context.Context, db repository.DB, email string) (*User, error)
+	// 更新前の取得用。トランザクション内で対象行をロックする。
+	FindByUIDForUpdate(ctx context.Context, db repository.DB, uid string) (*User, error)
+	// メールアドレスが他の有効なユーザーと重複する場合はErrEmailAlreadyExists（ErrConflictでラップ）を返す
+	// 役割は更新しない
+	Save(ctx context.Context, db repository.DB, user *User) (*User, error)
 }
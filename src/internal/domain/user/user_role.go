@@ -0,0 +1,21 @@
+package user
+
+// ユーザーの役割
+type Role string
+
+const (
+	// 全てのユーザーを管理できる
+	RoleAdmin Role = "admin"
+	// 自分自身のみ操作できる
+	RoleMember Role = "member"
+)
+
+// 定義済みの役割かどうか
+func (r Role) Valid() bool {
+	switch r {
+	case RoleAdmin, RoleMember:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,22 @@
+package user
+
+import (
+	"context"
+
+	"go-gin-domain/internal/domain/repository"
+)
+
+// メールアドレスが他の有効なユーザーと重複していないかをチェックする。
+// 同時実行による重複はDBの一意制約で防ぐため、リポジトリもErrEmailAlreadyExistsを返す。
+func EnsureUniqueEmail(ctx context.Context, db repository.DB, userRepo UserRepository, user *User) error {
+	existing, err := userRepo.FindByEmail(ctx, db, user.Email)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil && existing.UID != user.UID {
+		return NewErrEmailAlreadyExists()
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package user
+
+import (
+	"github.com/google/uuid"
+)
+
+// 値オブジェクトの定義
+type UID struct {
+	value string
+}
+
+// コンストラクタ（UUID形式のみ許可し、小文字のハイフン区切りに正規化する）
+func NewUID(value string) (UID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil || len(value) != 36 {
+		return UID{}, &ErrInvalidUID{}
+	}
+
+	return UID{value: id.String()}, nil
+}
+
+// 新しいUIDを生成する
+func GenerateUID() UID {
+	return UID{value: uuid.New().String()}
+}
+
+// 値を返すメソッド
+func (u UID) Value() string {
+	return u.value
+}
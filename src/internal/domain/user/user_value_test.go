@@ -0,0 +1,127 @@
+//go:build unit
+
+package user
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEmail(t *testing.T) {
+	t.Run("小文字に正規化すること", func(t *testing.T) {
+		// 処理実行
+		email, err := NewEmail(" T.Tanaka@Example.COM ")
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, "t.tanaka@example.com", email.Value())
+	})
+
+	t.Run("形式が不正な場合にErrInvalidEmailを返すこと", func(t *testing.T) {
+		values := []string{
+			"t.tanaka",
+			"t.tanaka@",
+			"@example.com",
+			"t.tanaka@localhost",
+			"t.tanaka@example.com.",
+			"田中 <t.tanaka@example.com>",
+			"t..tanaka@example.com",
+			strings.Repeat("a", 65) + "@example.com",
+			"t.tanaka@" + strings.Repeat("a", 250) + ".com",
+		}
+		for _, value := range values {
+			// 処理実行
+			_, err := NewEmail(value)
+
+			// 検証
+			var errInvalidEmail *ErrInvalidEmail
+			assert.ErrorAs(t, err, &errInvalidEmail, value)
+		}
+	})
+
+	t.Run("空の場合にErrEmptyEmailを返すこと", func(t *testing.T) {
+		// 処理実行
+		_, err := NewEmail(" ")
+
+		// 検証
+		var errEmptyEmail *ErrEmptyEmail
+		assert.ErrorAs(t, err, &errEmptyEmail)
+	})
+}
+
+func TestNewPersonName(t *testing.T) {
+	t.Run("各言語の名前を作成できること", func(t *testing.T) {
+		for _, value := range []string{"田中", "たなか", "タナカ・ジョン", "O'Brien", "Jean-Luc", "Zoë", "김"} {
+			// 処理実行
+			name, err := NewPersonName(value)
+
+			// 検証
+			assert.NoError(t, err, value)
+			assert.Equal(t, value, name.Value())
+		}
+	})
+
+	t.Run("最大文字数を超える場合にErrInvalidNameLengthを返すこと", func(t *testing.T) {
+		// 処理実行
+		_, okErr := NewPersonName(strings.Repeat("あ", MaxPersonNameLength))
+		_, err := NewPersonName(strings.Repeat("あ", MaxPersonNameLength+1))
+
+		// 検証
+		assert.NoError(t, okErr)
+		var errInvalidNameLength *ErrInvalidNameLength
+		assert.ErrorAs(t, err, &errInvalidNameLength)
+	})
+
+	t.Run("使用できない文字を含む場合にErrInvalidNameCharacterを返すこと", func(t *testing.T) {
+		for _, value := range []string{"田中1", "<script>", "田\n中", "田\x00中", "😀"} {
+			// 処理実行
+			_, err := NewPersonName(value)
+
+			// 検証
+			var errInvalidNameCharacter *ErrInvalidNameCharacter
+			assert.ErrorAs(t, err, &errInvalidNameCharacter, value)
+		}
+	})
+
+	t.Run("空の場合にErrEmptyNameを返すこと", func(t *testing.T) {
+		// 処理実行
+		_, err := NewPersonName("　")
+
+		// 検証
+		var errEmptyName *ErrEmptyName
+		assert.ErrorAs(t, err, &errEmptyName)
+	})
+}
+
+func TestNewUID(t *testing.T) {
+	t.Run("UUID形式の場合に小文字で作成できること", func(t *testing.T) {
+		// 処理実行
+		uid, err := NewUID("0196F1C2-7A3B-7C4D-8E5F-0A1B2C3D4E5F")
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, "0196f1c2-7a3b-7c4d-8e5f-0a1b2c3d4e5f", uid.Value())
+	})
+
+	t.Run("UUID形式以外の場合にErrInvalidUIDを返すこと", func(t *testing.T) {
+		for _, value := range []string{"", "xxxx-xxxx-xxxx-0001", "0196f1c27a3b7c4d8e5f0a1b2c3d4e5f", "urn:uuid:0196f1c2-7a3b-7c4d-8e5f-0a1b2c3d4e5f"} {
+			// 処理実行
+			_, err := NewUID(value)
+
+			// 検証
+			var errInvalidUID *ErrInvalidUID
+			assert.ErrorAs(t, err, &errInvalidUID, value)
+		}
+	})
+
+	t.Run("生成したUIDが有効であること", func(t *testing.T) {
+		// 処理実行
+		uid, err := NewUID(GenerateUID().Value())
+
+		// 検証
+		assert.NoError(t, err)
+		assert.NotEmpty(t, uid.Value())
+	})
+}
@@ -0,0 +1,266 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// JWKS（JSON Web Key Set）の形式
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA用
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC用
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// 公開鍵のキャッシュ（ファイルまたはエンドポイントから取得）
+type jwks struct {
+	mu        sync.RWMutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+	// 最後に取得を試みた日時（取得に失敗した場合も更新する）
+	attemptedAt time.Time
+	fetch       func(ctx context.Context) ([]byte, error)
+	// 同時に発生した再取得を1回にまとめる
+	group singleflight.Group
+	// キャッシュの有効期間（0の場合は再取得しない）
+	ttl time.Duration
+	// 再取得の最小間隔（取得に失敗し続ける場合にエンドポイントへの負荷を抑える）
+	minRefreshInterval time.Duration
+}
+
+// JWKSファイルから公開鍵を読み込む
+func newFileJWKS(path string) (*jwks, error) {
+	k := &jwks{
+		fetch: func(ctx context.Context) ([]byte, error) {
+			return os.ReadFile(path)
+		},
+	}
+
+	// ファイルの場合は起動時に読み込み、不正な内容であればエラーにする
+	if err := k.load(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+// JWKSエンドポイントから公開鍵を取得する（初回利用時に取得）
+func newRemoteJWKS(url string, client *http.Client) *jwks {
+	return &jwks{
+		fetch: func(ctx context.Context) ([]byte, error) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			res, err := client.Do(req)
+			if err != nil {
+				return nil, err
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != http.StatusOK {
+				return nil, fmt.Errorf("JWKSの取得に失敗しました。: status=%d", res.StatusCode)
+			}
+
+			return io.ReadAll(io.LimitReader(res.Body, 1<<20))
+		},
+		ttl:                10 * time.Minute,
+		minRefreshInterval: 30 * time.Second,
+	}
+}
+
+// kidに対応する公開鍵を返す
+func (k *jwks) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	k.mu.RLock()
+	key, found := k.lookup(kid)
+	stale := time.Since(k.fetchedAt) > k.ttl
+	k.mu.RUnlock()
+
+	// 期限切れ、または未知のkidの場合は再取得（鍵のローテーション対応）
+	if k.ttl > 0 && (stale || !found) {
+		if err := k.refresh(ctx); err != nil {
+			if found {
+				// 取得に失敗しても既存の鍵は使い続ける
+				return key, nil
+			}
+			return nil, err
+		}
+
+		k.mu.RLock()
+		key, found = k.lookup(kid)
+		k.mu.RUnlock()
+	}
+
+	if !found {
+		return nil, fmt.Errorf("kidに対応する公開鍵が存在しません。: kid=%s", kid)
+	}
+
+	return key, nil
+}
+
+// ロック取得済みの状態で呼び出すこと
+func (k *jwks) lookup(kid string) (crypto.PublicKey, bool) {
+	// kidが未指定で鍵が1つのみの場合はその鍵を利用する
+	if kid == "" && len(k.keys) == 1 {
+		for _, key := range k.keys {
+			return key, true
+		}
+	}
+
+	key, ok := k.keys[kid]
+	return key, ok
+}
+
+// 最小間隔内に取得を試みている場合は再取得しない
+// 同時に呼び出された場合は1回の取得結果を共有する
+func (k *jwks) refresh(ctx context.Context) error {
+	_, err, _ := k.group.Do("refresh", func() (any, error) {
+		k.mu.Lock()
+		if time.Since(k.attemptedAt) <= k.minRefreshInterval {
+			k.mu.Unlock()
+			return nil, nil
+		}
+		k.attemptedAt = time.Now()
+		k.mu.Unlock()
+
+		return nil, k.load(ctx)
+	})
+	return err
+}
+
+// 公開鍵を取得してキャッシュを置き換える
+func (k *jwks) load(ctx context.Context) error {
+	data, err := k.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys = keys
+	k.fetchedAt = time.Now()
+
+	return nil
+}
+
+func parseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var set jsonWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("JWKSの形式が不正です。: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		// 署名用以外の鍵は対象外
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("JWKSの鍵が不正です。: kid=%s: %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("JWKSに署名用の鍵が存在しません。")
+	}
+
+	return keys, nil
+}
+
+func (jwk jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("eの値が不正です。")
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("未対応の曲線です。: crv=%s", jwk.Crv)
+		}
+
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		key := &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+
+		// 曲線上の点であるかをチェック
+		if _, err := key.ECDH(); err != nil {
+			return nil, err
+		}
+
+		return key, nil
+	default:
+		return nil, fmt.Errorf("未対応の鍵タイプです。: kty=%s", jwk.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, fmt.Errorf("値が空です。")
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,111 @@
+//go:build unit
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWKS_Key(t *testing.T) {
+	ctx := context.Background()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(map[string]interface{}{"keys": []map[string]string{{
+		"kty": "RSA",
+		"kid": "rsa-key",
+		"n":   encodeBigInt(rsaKey.N),
+		"e":   encodeBigInt(big.NewInt(int64(rsaKey.E))),
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("取得に失敗した場合は最小間隔が経過するまで再取得しないこと", func(t *testing.T) {
+		var count atomic.Int32
+		k := &jwks{
+			fetch: func(ctx context.Context) ([]byte, error) {
+				count.Add(1)
+				return nil, errors.New("connection refused")
+			},
+			ttl:                10 * time.Minute,
+			minRefreshInterval: 30 * time.Second,
+		}
+
+		for range 3 {
+			key, err := k.key(ctx, "rsa-key")
+
+			assert.Error(t, err)
+			assert.Nil(t, key)
+		}
+		assert.Equal(t, int32(1), count.Load())
+	})
+
+	t.Run("同時に呼び出された場合も取得は1回のみであること", func(t *testing.T) {
+		var count atomic.Int32
+		release := make(chan struct{})
+		k := &jwks{
+			fetch: func(ctx context.Context) ([]byte, error) {
+				count.Add(1)
+				<-release
+				return data, nil
+			},
+			ttl:                10 * time.Minute,
+			minRefreshInterval: 30 * time.Second,
+		}
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				key, err := k.key(ctx, "rsa-key")
+
+				assert.NoError(t, err)
+				assert.NotNil(t, key)
+			}()
+		}
+		// 全ての呼び出しが取得を待つまで待機してから応答する
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), count.Load())
+	})
+
+	t.Run("未知のkidでも最小間隔が経過するまで再取得しないこと", func(t *testing.T) {
+		var count atomic.Int32
+		k := &jwks{
+			fetch: func(ctx context.Context) ([]byte, error) {
+				count.Add(1)
+				return data, nil
+			},
+			ttl:                10 * time.Minute,
+			minRefreshInterval: 30 * time.Second,
+		}
+
+		_, err := k.key(ctx, "rsa-key")
+		assert.NoError(t, err)
+
+		for range 3 {
+			key, err := k.key(ctx, "unknown")
+
+			assert.Error(t, err)
+			assert.Nil(t, key)
+		}
+		assert.Equal(t, int32(1), count.Load())
+	})
+}
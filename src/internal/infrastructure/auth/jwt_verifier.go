@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	auth_usecase "go-gin-domain/internal/application/usecase/auth"
+	"go-gin-domain/internal/infrastructure/httpclient"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type JWTConfig struct {
+	// HS256用の共有シークレット
+	HMACSecret string
+	// RS256/ES256用のJWKS（ファイルまたはエンドポイントのどちらかを指定）
+	JWKSFile string
+	JWKSURL  string
+	// 空の場合はチェックしない
+	Issuer   string
+	Audience string
+	// exp/nbfの判定で許容する時刻のずれ
+	Leeway time.Duration
+}
+
+type jwtVerifier struct {
+	hmacSecret []byte
+	jwks       *jwks
+	parser     *jwt.Parser
+}
+
+func NewJWTVerifier(cfg JWTConfig) (auth_usecase.TokenVerifier, error) {
+	v := &jwtVerifier{}
+
+	// 許可する署名アルゴリズム（alg=noneや想定外のアルゴリズムは拒否する）
+	var methods []string
+	if cfg.HMACSecret != "" {
+		v.hmacSecret = []byte(cfg.HMACSecret)
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+
+	switch {
+	case cfg.JWKSFile != "" && cfg.JWKSURL != "":
+		return nil, fmt.Errorf("JWKSはファイルとURLのどちらか一方を指定して下さい。")
+	case cfg.JWKSFile != "":
+		keys, err := newFileJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("JWKSファイルの読み込みに失敗しました。: %w", err)
+		}
+		v.jwks = keys
+	case cfg.JWKSURL != "":
+		v.jwks = newRemoteJWKS(cfg.JWKSURL, httpclient.NewClient(5*time.Second))
+	}
+	if v.jwks != nil {
+		methods = append(methods, jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg())
+	}
+
+	if len(methods) == 0 {
+		return nil, fmt.Errorf("JWTの検証用の鍵が設定されていません。")
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	v.parser = jwt.NewParser(opts...)
+
+	return v, nil
+}
+
+func (v *jwtVerifier) Verify(ctx context.Context, tokenString string) (*auth_usecase.Claims, error) {
+	claims := &jwt.RegisteredClaims{}
+	_, err := v.parser.ParseWithClaims(tokenString, claims, v.keyFunc(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, jwt.ErrTokenMalformed):
+			return nil, &auth_usecase.ErrTokenMalformed{}
+		case errors.Is(err, jwt.ErrTokenExpired):
+			return nil, &auth_usecase.ErrTokenExpired{}
+		default:
+			return nil, &auth_usecase.ErrTokenInvalid{}
+		}
+	}
+
+	// subはUIDとして利用するため必須
+	if claims.Subject == "" {
+		return nil, &auth_usecase.ErrTokenInvalid{}
+	}
+
+	return &auth_usecase.Claims{
+		Subject:   claims.Subject,
+		Issuer:    claims.Issuer,
+		Audience:  claims.Audience,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
+
+// トークンのアルゴリズムに応じて検証用の鍵を返す
+func (v *jwtVerifier) keyFunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			if len(v.hmacSecret) == 0 {
+				return nil, fmt.Errorf("HMACのシークレットが設定されていません。")
+			}
+			return v.hmacSecret, nil
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+			if v.jwks == nil {
+				return nil, fmt.Errorf("JWKSが設定されていません。")
+			}
+			kid, _ := token.Header["kid"].(string)
+			return v.jwks.key(ctx, kid)
+		default:
+			return nil, fmt.Errorf("未対応の署名アルゴリズムです。: alg=%s", token.Method.Alg())
+		}
+	}
+}
@@ -0,0 +1,271 @@
+//go:build unit
+
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	auth_usecase "go-gin-domain/internal/application/usecase/auth"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSecret = "testing-secret"
+
+// テスト用のクレーム
+func testClaims() jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Subject:   "xxxx-xxxx-xxxx-0001",
+		Issuer:    "go-gin-domain",
+		Audience:  jwt.ClaimStrings{"go-gin-domain-api"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		NotBefore: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+	}
+}
+
+func signHS256(t *testing.T, claims jwt.Claims, secret string) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// テスト用のJWKSファイルを作成
+func writeJWKS(t *testing.T, keys ...map[string]string) string {
+	t.Helper()
+	data, err := json.Marshal(map[string]interface{}{"keys": keys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func encodeBigInt(v *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(v.Bytes())
+}
+
+func TestJWTVerifier_Verify(t *testing.T) {
+	ctx := context.Background()
+
+	v, err := NewJWTVerifier(JWTConfig{
+		HMACSecret: testSecret,
+		Issuer:     "go-gin-domain",
+		Audience:   "go-gin-domain-api",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("HS256の正常なトークンの場合にクレームを返すこと", func(t *testing.T) {
+		claims, err := v.Verify(ctx, signHS256(t, testClaims(), testSecret))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "xxxx-xxxx-xxxx-0001", claims.Subject)
+		assert.Equal(t, "go-gin-domain", claims.Issuer)
+		assert.Equal(t, []string{"go-gin-domain-api"}, claims.Audience)
+	})
+
+	t.Run("有効期限切れの場合にErrTokenExpiredを返すこと", func(t *testing.T) {
+		c := testClaims()
+		c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+
+		_, err := v.Verify(ctx, signHS256(t, c, testSecret))
+
+		var errTokenExpired *auth_usecase.ErrTokenExpired
+		assert.ErrorAs(t, err, &errTokenExpired)
+	})
+
+	t.Run("形式が不正な場合にErrTokenMalformedを返すこと", func(t *testing.T) {
+		_, err := v.Verify(ctx, "xxxxxx")
+
+		var errTokenMalformed *auth_usecase.ErrTokenMalformed
+		assert.ErrorAs(t, err, &errTokenMalformed)
+	})
+
+	t.Run("署名が不正な場合にErrTokenInvalidを返すこと", func(t *testing.T) {
+		_, err := v.Verify(ctx, signHS256(t, testClaims(), "other-secret"))
+
+		var errTokenInvalid *auth_usecase.ErrTokenInvalid
+		assert.ErrorAs(t, err, &errTokenInvalid)
+	})
+
+	t.Run("有効期限が無い場合にErrTokenInvalidを返すこと", func(t *testing.T) {
+		c := testClaims()
+		c.ExpiresAt = nil
+
+		_, err := v.Verify(ctx, signHS256(t, c, testSecret))
+
+		var errTokenInvalid *auth_usecase.ErrTokenInvalid
+		assert.ErrorAs(t, err, &errTokenInvalid)
+	})
+
+	t.Run("nbfが未来の場合にErrTokenInvalidを返すこと", func(t *testing.T) {
+		c := testClaims()
+		c.NotBefore = jwt.NewNumericDate(time.Now().Add(time.Hour))
+
+		_, err := v.Verify(ctx, signHS256(t, c, testSecret))
+
+		var errTokenInvalid *auth_usecase.ErrTokenInvalid
+		assert.ErrorAs(t, err, &errTokenInvalid)
+	})
+
+	t.Run("issが異なる場合にErrTokenInvalidを返すこと", func(t *testing.T) {
+		c := testClaims()
+		c.Issuer = "other"
+
+		_, err := v.Verify(ctx, signHS256(t, c, testSecret))
+
+		var errTokenInvalid *auth_usecase.ErrTokenInvalid
+		assert.ErrorAs(t, err, &errTokenInvalid)
+	})
+
+	t.Run("audが異なる場合にErrTokenInvalidを返すこと", func(t *testing.T) {
+		c := testClaims()
+		c.Audience = jwt.ClaimStrings{"other"}
+
+		_, err := v.Verify(ctx, signHS256(t, c, testSecret))
+
+		var errTokenInvalid *auth_usecase.ErrTokenInvalid
+		assert.ErrorAs(t, err, &errTokenInvalid)
+	})
+
+	t.Run("subが無い場合にErrTokenInvalidを返すこと", func(t *testing.T) {
+		c := testClaims()
+		c.Subject = ""
+
+		_, err := v.Verify(ctx, signHS256(t, c, testSecret))
+
+		var errTokenInvalid *auth_usecase.ErrTokenInvalid
+		assert.ErrorAs(t, err, &errTokenInvalid)
+	})
+
+	t.Run("alg=noneの場合にErrTokenInvalidを返すこと", func(t *testing.T) {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodNone, testClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = v.Verify(ctx, token)
+
+		var errTokenInvalid *auth_usecase.ErrTokenInvalid
+		assert.ErrorAs(t, err, &errTokenInvalid)
+	})
+}
+
+func TestJWTVerifier_Verify_JWKS(t *testing.T) {
+	ctx := context.Background()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := writeJWKS(t,
+		map[string]string{
+			"kty": "RSA",
+			"kid": "rsa-key",
+			"use": "sig",
+			"n":   encodeBigInt(rsaKey.N),
+			"e":   encodeBigInt(big.NewInt(int64(rsaKey.E))),
+		},
+		map[string]string{
+			"kty": "EC",
+			"kid": "ec-key",
+			"crv": "P-256",
+			"x":   encodeBigInt(ecKey.X),
+			"y":   encodeBigInt(ecKey.Y),
+		},
+	)
+
+	v, err := NewJWTVerifier(JWTConfig{JWKSFile: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("RS256の正常なトークンの場合にクレームを返すこと", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, testClaims())
+		token.Header["kid"] = "rsa-key"
+		tokenString, err := token.SignedString(rsaKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		claims, err := v.Verify(ctx, tokenString)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "xxxx-xxxx-xxxx-0001", claims.Subject)
+	})
+
+	t.Run("ES256の正常なトークンの場合にクレームを返すこと", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, testClaims())
+		token.Header["kid"] = "ec-key"
+		tokenString, err := token.SignedString(ecKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		claims, err := v.Verify(ctx, tokenString)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "xxxx-xxxx-xxxx-0001", claims.Subject)
+	})
+
+	t.Run("kidが存在しない場合にErrTokenInvalidを返すこと", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, testClaims())
+		token.Header["kid"] = "unknown"
+		tokenString, err := token.SignedString(rsaKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = v.Verify(ctx, tokenString)
+
+		var errTokenInvalid *auth_usecase.ErrTokenInvalid
+		assert.ErrorAs(t, err, &errTokenInvalid)
+	})
+
+	t.Run("HMACのシークレット未設定でHS256の場合にErrTokenInvalidを返すこと", func(t *testing.T) {
+		_, err := v.Verify(ctx, signHS256(t, testClaims(), testSecret))
+
+		var errTokenInvalid *auth_usecase.ErrTokenInvalid
+		assert.ErrorAs(t, err, &errTokenInvalid)
+	})
+}
+
+func TestNewJWTVerifier(t *testing.T) {
+	t.Run("鍵が未設定の場合にエラーを返すこと", func(t *testing.T) {
+		v, err := NewJWTVerifier(JWTConfig{})
+
+		assert.Error(t, err)
+		assert.Nil(t, v)
+	})
+
+	t.Run("JWKSファイルが存在しない場合にエラーを返すこと", func(t *testing.T) {
+		v, err := NewJWTVerifier(JWTConfig{JWKSFile: filepath.Join(t.TempDir(), "none.json")})
+
+		assert.Error(t, err)
+		assert.Nil(t, v)
+	})
+}
@@ -0,0 +1,25 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"go-gin-domain/internal/domain/repository"
+)
+
+// SQLを実行するDB接続（*sql.DBと*sql.Txの両方が満たす）
+type Conn interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// リポジトリに渡されたハンドルをSQLのDB接続として取得する
+func AsConn(db repository.DB) (Conn, error) {
+	conn, ok := db.(Conn)
+	if !ok || conn == nil {
+		return nil, fmt.Errorf("DB接続の種類が不正です。: %T", db)
+	}
+	return conn, nil
+}
@@ -0,0 +1,30 @@
+//go:build unit
+
+package database
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsConn(t *testing.T) {
+	t.Run("*sql.DBと*sql.TxをDB接続として取得できること", func(t *testing.T) {
+		conn, err := AsConn(&sql.DB{})
+		assert.NoError(t, err)
+		assert.NotNil(t, conn)
+
+		conn, err = AsConn(&sql.Tx{})
+		assert.NoError(t, err)
+		assert.NotNil(t, conn)
+	})
+
+	t.Run("SQLのDB接続以外の場合はエラーを返すこと", func(t *testing.T) {
+		_, err := AsConn(nil)
+		assert.EqualError(t, err, "DB接続の種類が不正です。: <nil>")
+
+		_, err = AsConn("db")
+		assert.EqualError(t, err, "DB接続の種類が不正です。: string")
+	})
+}
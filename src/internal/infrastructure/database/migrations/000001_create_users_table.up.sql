@@ -0,0 +1,10 @@
+CREATE TABLE users (
+    id         BIGSERIAL    PRIMARY KEY,
+    uid        VARCHAR(255) NOT NULL UNIQUE,
+    last_name  VARCHAR(255) NOT NULL,
+    first_name VARCHAR(255) NOT NULL,
+    email      VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
@@ -0,0 +1,4 @@
+-- ユーザー一覧の並び替え（キーセットページネーション）用のインデックス
+CREATE INDEX IF NOT EXISTS idx_users_created_at_id ON users (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_users_last_name_id ON users (last_name, id);
+CREATE INDEX IF NOT EXISTS idx_users_email_id ON users (email, id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_posts_created_at;
+DROP INDEX IF EXISTS idx_posts_author_uid_id;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS author_uid;
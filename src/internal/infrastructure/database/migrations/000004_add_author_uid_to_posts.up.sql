@@ -0,0 +1,5 @@
+-- 既存のPostは投稿者が不明のためNULLを許容する
+ALTER TABLE posts ADD COLUMN author_uid VARCHAR(255);
+
+CREATE INDEX idx_posts_author_uid_id ON posts (author_uid, id);
+CREATE INDEX idx_posts_created_at ON posts (created_at);
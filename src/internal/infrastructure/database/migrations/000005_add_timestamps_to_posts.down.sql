@@ -0,0 +1,4 @@
+ALTER TABLE posts DROP CONSTRAINT IF EXISTS fk_posts_author_uid;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE posts DROP COLUMN IF EXISTS updated_at;
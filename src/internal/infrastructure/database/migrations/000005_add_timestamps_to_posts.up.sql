@@ -0,0 +1,6 @@
+ALTER TABLE posts ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+ALTER TABLE posts ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- 投稿者はユーザーのUIDを参照する（ユーザーは論理削除のため行は残る）
+ALTER TABLE posts
+    ADD CONSTRAINT fk_posts_author_uid FOREIGN KEY (author_uid) REFERENCES users (uid);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS users_email_active_key;
@@ -0,0 +1,2 @@
+-- 論理削除済みのユーザーを除いてメールアドレスを一意にする
+CREATE UNIQUE INDEX users_email_active_key ON users (email) WHERE deleted_at IS NULL;
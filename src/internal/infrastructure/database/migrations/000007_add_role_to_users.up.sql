@@ -0,0 +1,3 @@
+-- 既存のユーザーはmemberとする（adminへの変更はDBを直接更新する）
+ALTER TABLE users ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'member';
+ALTER TABLE users ADD CONSTRAINT users_role_check CHECK (role IN ('admin', 'member'));
@@ -0,0 +1,8 @@
+package migrations
+
+import "embed"
+
+// マイグレーションファイル（{バージョン}_{名前}.up.sql / {バージョン}_{名前}.down.sql）
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,335 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-gin-domain/internal/application/usecase/logger"
+)
+
+// 複数インスタンスの同時実行を防ぐためのアドバイザリーロックのキー
+const migrationLockKey int64 = 7_245_019_283_651_100_001
+
+// マイグレーションファイル名の形式（例：000001_create_users_table.up.sql）
+var migrationFileRegexp = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// マイグレーション名の形式
+var migrationNameRegexp = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+type Migration struct {
+	Version int64
+	Name    string
+	up      string
+	down    string
+}
+
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+	// 適用済みだがファイルが存在しない場合にtrue
+	Missing bool
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+	logger     logger.Logger
+}
+
+func NewMigrator(db *sql.DB, fsys fs.FS, logger logger.Logger) (*Migrator, error) {
+	migrations, err := loadMigrations(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		logger:     logger,
+	}, nil
+}
+
+// 未適用のマイグレーションを全て適用し、適用した件数を返す
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	count := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+
+			err := runInTx(ctx, conn, migration.up, `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, migration.Version, migration.Name)
+			if err != nil {
+				return fmt.Errorf("マイグレーションの適用に失敗しました。: %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			m.logger.Info(ctx, "Applied migration", "version", migration.Version, "name", migration.Name)
+			count++
+		}
+
+		return nil
+	})
+
+	return count, err
+}
+
+// 適用済みのマイグレーションを新しい順にn件ロールバックし、ロールバックした件数を返す
+func (m *Migrator) Down(ctx context.Context, n int) (int, error) {
+	if n <= 0 {
+		return 0, fmt.Errorf("ロールバックする件数は1以上を指定して下さい。")
+	}
+
+	count := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && count < n; i-- {
+			migration := m.migrations[i]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+			if migration.down == "" {
+				return fmt.Errorf("ロールバック用のファイルが存在しません。: %d_%s", migration.Version, migration.Name)
+			}
+
+			err := runInTx(ctx, conn, migration.down, `DELETE FROM schema_migrations WHERE version = $1`, migration.Version)
+			if err != nil {
+				return fmt.Errorf("マイグレーションのロールバックに失敗しました。: %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			m.logger.Info(ctx, "Rolled back migration", "version", migration.Version, "name", migration.Name)
+			count++
+		}
+
+		return nil
+	})
+
+	return count, err
+}
+
+// マイグレーションの適用状況をバージョン順に返す
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var statuses []MigrationStatus
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			status := MigrationStatus{Version: migration.Version, Name: migration.Name}
+			if a, ok := applied[migration.Version]; ok {
+				status.AppliedAt = &a.appliedAt
+				delete(applied, migration.Version)
+			}
+			statuses = append(statuses, status)
+		}
+
+		// 適用済みだがファイルが存在しないもの
+		for version, a := range applied {
+			appliedAt := a.appliedAt
+			statuses = append(statuses, MigrationStatus{Version: version, Name: a.name, AppliedAt: &appliedAt, Missing: true})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+
+	return statuses, nil
+}
+
+// アドバイザリーロックを取得した接続で処理を実行する
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) (err error) {
+	// ロックは接続単位のため、同じ接続を使い続ける
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// 他のインスタンスが実行中の場合は完了するまで待機する
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationLockKey); err != nil {
+		return fmt.Errorf("マイグレーション用のロックの取得に失敗しました。: %w", err)
+	}
+	defer func() {
+		if _, unlockErr := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, migrationLockKey); unlockErr != nil {
+			err = errors.Join(err, fmt.Errorf("マイグレーション用のロックの解放に失敗しました。: %w", unlockErr))
+		}
+	}()
+
+	query := `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    BIGINT      PRIMARY KEY,
+			name       TEXT        NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`
+	if _, err := conn.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("schema_migrationsテーブルの作成に失敗しました。: %w", err)
+	}
+
+	return fn(conn)
+}
+
+type appliedMigration struct {
+	name      string
+	appliedAt time.Time
+}
+
+func appliedMigrations(ctx context.Context, conn *sql.Conn) (map[int64]appliedMigration, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, name, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int64]appliedMigration{}
+	for rows.Next() {
+		var version int64
+		var a appliedMigration
+		if err := rows.Scan(&version, &a.name, &a.appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = a
+	}
+
+	return applied, rows.Err()
+}
+
+// マイグレーションのSQLと管理テーブルの更新を1つのトランザクションで実行する
+func runInTx(ctx context.Context, conn *sql.Conn, migrationSQL, recordSQL string, args ...any) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, migrationSQL); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, recordSQL, args...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func loadMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+
+		match := migrationFileRegexp.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("マイグレーションファイル名の形式が不正です。: %s", entry.Name())
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("マイグレーションファイル名の形式が不正です。: %s", entry.Name())
+		}
+
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("バージョンが重複しています。: %d", version)
+		}
+
+		if match[3] == "up" {
+			migration.up = string(data)
+		} else {
+			migration.down = string(data)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.up == "" {
+			return nil, fmt.Errorf("適用用のファイルが存在しません。: %d_%s", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// 次のバージョンでマイグレーションファイル（up/down）を作成し、作成したファイルのパスを返す
+func CreateMigrationFiles(dir, name string) ([]string, error) {
+	if !migrationNameRegexp.MatchString(name) {
+		return nil, fmt.Errorf("マイグレーション名は英小文字、数字、アンダースコアのみ利用可能です。: %s", name)
+	}
+
+	migrations, err := loadMigrations(os.DirFS(dir))
+	if err != nil {
+		return nil, err
+	}
+
+	var version int64 = 1
+	if len(migrations) > 0 {
+		version = migrations[len(migrations)-1].Version + 1
+	}
+
+	var paths []string
+	for _, direction := range []string{"up", "down"} {
+		fileName := fmt.Sprintf("%06d_%s.%s.sql", version, name, direction)
+		path := filepath.Join(dir, fileName)
+
+		// 既存ファイルは上書きしない
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		_, err = fmt.Fprintf(f, "-- %s\n", fileName)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,100 @@
+//go:build unit
+
+package database
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"go-gin-domain/internal/infrastructure/database/migrations"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	t.Run("埋め込みのマイグレーションをバージョン順に読み込めること", func(t *testing.T) {
+		// 処理実行
+		list, err := loadMigrations(migrations.FS)
+
+		// 検証
+		assert.NoError(t, err)
+		assert.NotEmpty(t, list)
+		for i, migration := range list {
+			assert.NotEmpty(t, migration.up)
+			assert.NotEmpty(t, migration.down)
+			if i > 0 {
+				assert.Greater(t, migration.Version, list[i-1].Version)
+			}
+		}
+	})
+
+	t.Run("ファイル名の形式が不正な場合にエラーを返すこと", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"create_users.sql": {Data: []byte("SELECT 1;")},
+		}
+
+		// 処理実行
+		_, err := loadMigrations(fsys)
+
+		// 検証
+		assert.Error(t, err)
+	})
+
+	t.Run("upファイルが存在しない場合にエラーを返すこと", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"000001_create_users.down.sql": {Data: []byte("DROP TABLE users;")},
+		}
+
+		// 処理実行
+		_, err := loadMigrations(fsys)
+
+		// 検証
+		assert.Error(t, err)
+	})
+
+	t.Run("バージョンが重複している場合にエラーを返すこと", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"000001_create_users.up.sql": {Data: []byte("CREATE TABLE users ();")},
+			"000001_create_posts.up.sql": {Data: []byte("CREATE TABLE posts ();")},
+		}
+
+		// 処理実行
+		_, err := loadMigrations(fsys)
+
+		// 検証
+		assert.Error(t, err)
+	})
+}
+
+func TestCreateMigrationFiles(t *testing.T) {
+	t.Run("次のバージョンでup/downのファイルが作成されること", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, "000001_create_users.up.sql"), []byte("CREATE TABLE users ();"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		// 処理実行
+		paths, err := CreateMigrationFiles(dir, "create_posts")
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, []string{
+			filepath.Join(dir, "000002_create_posts.up.sql"),
+			filepath.Join(dir, "000002_create_posts.down.sql"),
+		}, paths)
+
+		list, err := loadMigrations(os.DirFS(dir))
+		assert.NoError(t, err)
+		assert.Len(t, list, 2)
+	})
+
+	t.Run("マイグレーション名が不正な場合にエラーを返すこと", func(t *testing.T) {
+		// 処理実行
+		_, err := CreateMigrationFiles(t.TempDir(), "Create Posts")
+
+		// 検証
+		assert.Error(t, err)
+	})
+}
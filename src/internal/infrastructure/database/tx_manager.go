@@ -0,0 +1,49 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"go-gin-domain/internal/application/usecase/transaction"
+	"go-gin-domain/internal/domain/repository"
+)
+
+type sqlTxManager struct {
+	db *sql.DB
+}
+
+func NewSQLTxManager(db *sql.DB) transaction.TxManager {
+	return &sqlTxManager{
+		db: db,
+	}
+}
+
+func (m *sqlTxManager) Do(ctx context.Context, fn func(ctx context.Context, tx repository.DB) error) (err error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("トランザクションの開始に失敗しました。: %w", err)
+	}
+
+	// panicした場合はロールバックしてから再度panicさせる
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(ctx, tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Join(err, fmt.Errorf("ロールバックに失敗しました。: %w", rbErr))
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("コミットに失敗しました。: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+	"sync"
+
+	"go-gin-domain/internal/application/usecase/transaction"
+	"go-gin-domain/internal/domain/repository"
+)
+
+// インメモリのトランザクション。
+// リポジトリは変更のたびに元に戻す処理を登録し、ロールバック時は登録と逆順に実行する。
+// （ストア全体を開始時点に戻すと、トランザクション外で並行して登録されたデータまで消えるため、変更した分のみ戻す）
+type MemoryTx struct {
+	undo []func()
+}
+
+// dbがインメモリのトランザクションの場合、ロールバック時に実行する処理を登録する
+// （トランザクション外の変更は即時に確定するため何もしない）
+func OnRollback(db repository.DB, undo func()) {
+	if tx, ok := db.(*MemoryTx); ok && tx != nil {
+		tx.undo = append(tx.undo, undo)
+	}
+}
+
+func (tx *MemoryTx) rollback() {
+	for i := len(tx.undo) - 1; i >= 0; i-- {
+		tx.undo[i]()
+	}
+	tx.undo = nil
+}
+
+type memoryTxManager struct {
+	mu sync.Mutex
+}
+
+// インメモリのストア用のトランザクション管理。
+// トランザクション同士は直列に実行し、ロールバック時はトランザクション内の変更のみを元に戻す。
+func NewMemoryTxManager() transaction.TxManager {
+	return &memoryTxManager{}
+}
+
+func (m *memoryTxManager) Do(ctx context.Context, fn func(ctx context.Context, tx repository.DB) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tx := &MemoryTx{}
+
+	// panicした場合はロールバックしてから再度panicさせる
+	defer func() {
+		if p := recover(); p != nil {
+			tx.rollback()
+			panic(p)
+		}
+	}()
+
+	// リポジトリにはトランザクションを渡し、変更を元に戻す処理を登録させる
+	if err := fn(ctx, tx); err != nil {
+		tx.rollback()
+		return err
+	}
+
+	return nil
+}
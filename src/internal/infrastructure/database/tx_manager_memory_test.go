@@ -0,0 +1,84 @@
+//go:build unit
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"go-gin-domain/internal/domain/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// テスト用のストア（変更時にロールバック用の処理を登録する）
+type testStore struct {
+	values []string
+}
+
+func (s *testStore) add(db repository.DB, value string) {
+	s.values = append(s.values, value)
+	OnRollback(db, func() {
+		s.values = s.values[:len(s.values)-1]
+	})
+}
+
+func TestMemoryTxManager_Do(t *testing.T) {
+	t.Run("正常終了した場合に変更が反映されること", func(t *testing.T) {
+		store := &testStore{values: []string{"before"}}
+		txManager := NewMemoryTxManager()
+
+		// 処理実行
+		err := txManager.Do(context.Background(), func(ctx context.Context, tx repository.DB) error {
+			store.add(tx, "after")
+			return nil
+		})
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"before", "after"}, store.values)
+	})
+
+	t.Run("エラーの場合に登録と逆順でロールバックされること", func(t *testing.T) {
+		store := &testStore{values: []string{"before"}}
+		txManager := NewMemoryTxManager()
+
+		// 処理実行
+		err := txManager.Do(context.Background(), func(ctx context.Context, tx repository.DB) error {
+			store.add(tx, "after1")
+			store.add(tx, "after2")
+			return fmt.Errorf("Internal Server Error")
+		})
+
+		// 検証
+		assert.Error(t, err)
+		assert.Equal(t, []string{"before"}, store.values)
+	})
+
+	t.Run("panicの場合にロールバックされること", func(t *testing.T) {
+		store := &testStore{values: []string{"before"}}
+		txManager := NewMemoryTxManager()
+
+		// 処理実行
+		assert.Panics(t, func() {
+			_ = txManager.Do(context.Background(), func(ctx context.Context, tx repository.DB) error {
+				store.add(tx, "after")
+				panic("panic")
+			})
+		})
+
+		// 検証
+		assert.Equal(t, []string{"before"}, store.values)
+	})
+
+	t.Run("トランザクション外の変更はロールバックの対象外であること", func(t *testing.T) {
+		store := &testStore{}
+
+		// 処理実行
+		store.add(nil, "outside")
+
+		// 検証
+		assert.Equal(t, []string{"outside"}, store.values)
+	})
+}
@@ -0,0 +1,41 @@
+package httpclient
+
+import (
+	"net/http"
+	"time"
+
+	"go-gin-domain/internal/presentation/contextkey"
+)
+
+// 外部APIへのリクエストに、共通コンテキストのリクエストIDをX-Request-Idヘッダーとして付与する
+type requestIdTransport struct {
+	base http.RoundTripper
+}
+
+// baseがnilの場合はhttp.DefaultTransportを使用する
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &requestIdTransport{base: base}
+}
+
+func (t *requestIdTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestId, ok := req.Context().Value(contextkey.RequestId).(string)
+	if !ok || requestId == "" || req.Header.Get(contextkey.HeaderRequestId) != "" {
+		return t.base.RoundTrip(req)
+	}
+
+	// RoundTripperはリクエストを変更してはいけないため、複製してヘッダーを設定する
+	req = req.Clone(req.Context())
+	req.Header.Set(contextkey.HeaderRequestId, requestId)
+	return t.base.RoundTrip(req)
+}
+
+// リクエストIDを伝播するHTTPクライアント（外部APIへのリクエストはこのクライアントを使用する）
+func NewClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: NewTransport(nil),
+	}
+}
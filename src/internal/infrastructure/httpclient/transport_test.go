@@ -0,0 +1,65 @@
+//go:build unit
+
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go-gin-domain/internal/presentation/contextkey"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient(t *testing.T) {
+	// 受信したX-Request-Idを記録するサーバー
+	var received string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get(contextkey.HeaderRequestId)
+	}))
+	defer srv.Close()
+
+	client := NewClient(time.Second)
+
+	t.Run("共通コンテキストのリクエストIDをヘッダーに付与すること", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), contextkey.RequestId, "req-0001")
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		require.NoError(t, err)
+
+		res, err := client.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+
+		assert.Equal(t, "req-0001", received)
+		// 元のリクエストは変更しない
+		assert.Empty(t, req.Header.Get(contextkey.HeaderRequestId))
+	})
+
+	t.Run("ヘッダーが設定済みの場合は上書きしないこと", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), contextkey.RequestId, "req-0001")
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		require.NoError(t, err)
+		req.Header.Set(contextkey.HeaderRequestId, "req-0002")
+
+		res, err := client.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+
+		assert.Equal(t, "req-0002", received)
+	})
+
+	t.Run("リクエストIDが無い場合はヘッダーを付与しないこと", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL, nil)
+		require.NoError(t, err)
+
+		res, err := client.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+
+		assert.Empty(t, received)
+	})
+}
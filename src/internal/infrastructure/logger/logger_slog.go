@@ -2,57 +2,171 @@ package logger
 
 import (
 	"context"
+	"io"
 	"log/slog"
 	"os"
+	"runtime"
+	"strings"
+	"time"
 
 	logger_usecase "go-gin-domain/internal/application/usecase/logger"
-	"go-gin-domain/internal/presentation/middleware"
+	"go-gin-domain/internal/presentation/contextkey"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 出力形式
+const (
+	FormatText = "text"
+	FormatJSON = "json"
 )
 
+// マスクした値
+const redacted = "[REDACTED]"
+
 // slogの設定
+type Config struct {
+	// 出力形式（text、json）。空の場合はtext。
+	Format string
+	// 出力する最低レベル。nilの場合はInfo。
+	Level slog.Leveler
+	// 出力先。nilの場合は標準出力。
+	Output io.Writer
+	// 呼び出し元のファイルと行番号を出力するか
+	AddSource bool
+	// 値をマスクする属性のキー（例：email）。大文字と小文字は区別しない。
+	RedactKeys []string
+	// Info以下のログのサンプリング。nilの場合はサンプリングしない。
+	Sampling *SamplingConfig
+}
+
+// slogのハンドラー（リクエスト単位の情報の付与、PIIのマスク、サンプリングを行う）
 type SlogHandler struct {
 	slog.Handler
+	sampler *sampler
+}
+
+func NewSlogHandler(cfg Config) *SlogHandler {
+	output := cfg.Output
+	if output == nil {
+		output = os.Stdout
+	}
+
+	redactKeys := make(map[string]struct{}, len(cfg.RedactKeys))
+	for _, key := range cfg.RedactKeys {
+		redactKeys[strings.ToLower(key)] = struct{}{}
+	}
+
+	opts := &slog.HandlerOptions{
+		AddSource: cfg.AddSource,
+		Level:     cfg.Level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if _, ok := redactKeys[strings.ToLower(a.Key)]; ok {
+				return slog.String(a.Key, redacted)
+			}
+			return a
+		},
+	}
+
+	var handler slog.Handler
+	switch cfg.Format {
+	case FormatJSON:
+		handler = slog.NewJSONHandler(output, opts)
+	default:
+		handler = slog.NewTextHandler(output, opts)
+	}
+
+	return &SlogHandler{
+		Handler: handler,
+		sampler: newSampler(cfg.Sampling),
+	}
 }
 
 func (h *SlogHandler) Handle(ctx context.Context, r slog.Record) error {
-	requestId, ok := ctx.Value(middleware.RequestId).(string)
+	// 大量に出力されるInfo以下のログは間引く（対象外のコンテキストの場合は除く）
+	skipSampling, _ := ctx.Value(contextkey.SkipSampling).(bool)
+	if r.Level <= slog.LevelInfo && !skipSampling && !h.sampler.allow(r.Level, r.Message) {
+		return nil
+	}
+
+	requestId, ok := ctx.Value(contextkey.RequestId).(string)
 	if ok {
 		r.AddAttrs(slog.Attr{Key: "requestId", Value: slog.String("requestId", requestId).Value})
 	}
 
-	xRequestSource, ok := ctx.Value(middleware.XRequestSource).(string)
+	xRequestSource, ok := ctx.Value(contextkey.XRequestSource).(string)
 	if ok {
 		r.AddAttrs(slog.Attr{Key: "xRequestSource", Value: slog.String("xRequestSource", xRequestSource).Value})
 	}
 
-	uid, ok := ctx.Value(middleware.UID).(string)
+	uid, ok := ctx.Value(contextkey.UID).(string)
 	if ok {
 		r.AddAttrs(slog.Attr{Key: "UID", Value: slog.String("UID", uid).Value})
 	}
 
+	// トレースと関連付けるためのIDを追加
+	spanContext := trace.SpanContextFromContext(ctx)
+	if spanContext.IsValid() {
+		r.AddAttrs(slog.String("traceId", spanContext.TraceID().String()))
+		r.AddAttrs(slog.String("spanId", spanContext.SpanID().String()))
+	}
+
 	return h.Handler.Handle(ctx, r)
 }
 
-var slogHandler = &SlogHandler{
-	slog.NewTextHandler(os.Stdout, nil),
+// 属性やグループを追加した場合もHandleを経由させる
+func (h *SlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &SlogHandler{Handler: h.Handler.WithAttrs(attrs), sampler: h.sampler}
+}
+
+func (h *SlogHandler) WithGroup(name string) slog.Handler {
+	return &SlogHandler{Handler: h.Handler.WithGroup(name), sampler: h.sampler}
 }
-var logger = slog.New(slogHandler)
 
 // ロガーの設定
-type slogLogger struct{}
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+func NewSlogLogger(handler slog.Handler) logger_usecase.Logger {
+	return &slogLogger{
+		logger: slog.New(handler),
+	}
+}
 
-func NewSlogLogger() logger_usecase.Logger {
-	return &slogLogger{}
+func (l *slogLogger) Debug(ctx context.Context, message string, args ...any) {
+	l.log(ctx, slog.LevelDebug, message, args...)
 }
 
-func (l *slogLogger) Info(ctx context.Context, message string) {
-	logger.InfoContext(ctx, message)
+func (l *slogLogger) Info(ctx context.Context, message string, args ...any) {
+	l.log(ctx, slog.LevelInfo, message, args...)
 }
 
-func (l *slogLogger) Warn(ctx context.Context, message string) {
-	logger.WarnContext(ctx, message)
+func (l *slogLogger) Warn(ctx context.Context, message string, args ...any) {
+	l.log(ctx, slog.LevelWarn, message, args...)
 }
 
-func (l *slogLogger) Error(ctx context.Context, message string) {
-	logger.ErrorContext(ctx, message)
+func (l *slogLogger) Error(ctx context.Context, message string, args ...any) {
+	l.log(ctx, slog.LevelError, message, args...)
+}
+
+func (l *slogLogger) With(args ...any) logger_usecase.Logger {
+	return &slogLogger{
+		logger: l.logger.With(args...),
+	}
+}
+
+// 呼び出し元（Debug、Info、Warn、Errorを呼び出した箇所）をソースの位置として出力する
+func (l *slogLogger) log(ctx context.Context, level slog.Level, message string, args ...any) {
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+
+	// runtime.Callers、log、Debug（Info、Warn、Error）の分をスキップする
+	var pcs [1]uintptr
+	runtime.Callers(3, pcs[:])
+
+	r := slog.NewRecord(time.Now(), level, message, pcs[0])
+	r.Add(args...)
+	_ = l.logger.Handler().Handle(ctx, r)
 }
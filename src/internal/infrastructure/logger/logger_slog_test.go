@@ -0,0 +1,233 @@
+//go:build unit
+
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"go-gin-domain/internal/presentation/contextkey"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// JSON形式のログを1行ずつ取得する
+func decodeLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var m map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &m))
+		lines = append(lines, m)
+	}
+	return lines
+}
+
+func TestSlogLogger(t *testing.T) {
+	t.Run("JSON形式でリクエスト単位の情報を付与して出力すること", func(t *testing.T) {
+		var buf bytes.Buffer
+		l := NewSlogLogger(NewSlogHandler(Config{Format: FormatJSON, Output: &buf}))
+
+		ctx := context.WithValue(context.Background(), contextkey.RequestId, "request-id")
+		l.Info(ctx, "メッセージ")
+
+		lines := decodeLines(t, &buf)
+		require.Len(t, lines, 1)
+		assert.Equal(t, "INFO", lines[0]["level"])
+		assert.Equal(t, "メッセージ", lines[0]["msg"])
+		assert.Equal(t, "request-id", lines[0]["requestId"])
+	})
+
+	t.Run("コンテキストにスパンがある場合はtraceIdとspanIdを出力すること", func(t *testing.T) {
+		var buf bytes.Buffer
+		l := NewSlogLogger(NewSlogHandler(Config{Format: FormatJSON, Output: &buf}))
+
+		traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+		ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}))
+		l.Info(ctx, "メッセージ")
+		l.Info(context.Background(), "スパンなし")
+
+		lines := decodeLines(t, &buf)
+		require.Len(t, lines, 2)
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", lines[0]["traceId"])
+		assert.Equal(t, "00f067aa0ba902b7", lines[0]["spanId"])
+		// スパンが無い場合は出力しない
+		assert.NotContains(t, lines[1], "traceId")
+		assert.NotContains(t, lines[1], "spanId")
+	})
+
+	t.Run("設定したレベル未満のログは出力しないこと", func(t *testing.T) {
+		var buf bytes.Buffer
+		l := NewSlogLogger(NewSlogHandler(Config{Format: FormatJSON, Level: slog.LevelWarn, Output: &buf}))
+
+		l.Info(context.Background(), "info")
+		l.Warn(context.Background(), "warn")
+		l.Error(context.Background(), "error")
+
+		lines := decodeLines(t, &buf)
+		require.Len(t, lines, 2)
+		assert.Equal(t, "warn", lines[0]["msg"])
+		assert.Equal(t, "error", lines[1]["msg"])
+	})
+
+	t.Run("ソースの位置は呼び出し元を出力すること", func(t *testing.T) {
+		var buf bytes.Buffer
+		l := NewSlogLogger(NewSlogHandler(Config{Format: FormatJSON, Output: &buf, AddSource: true}))
+
+		l.Info(context.Background(), "メッセージ")
+
+		lines := decodeLines(t, &buf)
+		require.Len(t, lines, 1)
+		source, ok := lines[0]["source"].(map[string]any)
+		require.True(t, ok)
+		assert.Contains(t, source["file"], "logger_slog_test.go")
+	})
+}
+
+func TestSlogLogger_Attrs(t *testing.T) {
+	t.Run("属性をキーと値の組で出力すること", func(t *testing.T) {
+		var buf bytes.Buffer
+		l := NewSlogLogger(NewSlogHandler(Config{Format: FormatJSON, Level: slog.LevelDebug, Output: &buf}))
+
+		l.Debug(context.Background(), "デバッグ", "postId", 1)
+		l.Warn(context.Background(), "対象ユーザーが存在しません。", "uid", "xxxx-xxxx-xxxx-0001", "error", errors.New("not found"))
+
+		lines := decodeLines(t, &buf)
+		require.Len(t, lines, 2)
+		assert.Equal(t, "DEBUG", lines[0]["level"])
+		assert.Equal(t, float64(1), lines[0]["postId"])
+		assert.Equal(t, "xxxx-xxxx-xxxx-0001", lines[1]["uid"])
+		assert.Equal(t, "not found", lines[1]["error"])
+	})
+
+	t.Run("Withで作成した子ロガーは属性を全てのログに付与すること", func(t *testing.T) {
+		var buf bytes.Buffer
+		l := NewSlogLogger(NewSlogHandler(Config{Format: FormatJSON, Output: &buf, RedactKeys: []string{"email"}}))
+
+		child := l.With("component", "migrator", "email", "t.tanaka@example.com")
+		ctx := context.WithValue(context.Background(), contextkey.RequestId, "request-id")
+		child.Info(ctx, "1件目")
+		child.Info(ctx, "2件目")
+		l.Info(ctx, "親")
+
+		lines := decodeLines(t, &buf)
+		require.Len(t, lines, 3)
+		for _, line := range lines[:2] {
+			assert.Equal(t, "migrator", line["component"])
+			assert.Equal(t, "[REDACTED]", line["email"])
+			assert.Equal(t, "request-id", line["requestId"])
+		}
+		assert.NotContains(t, lines[2], "component")
+	})
+}
+
+func TestSlogHandler_Redact(t *testing.T) {
+	t.Run("指定したキーの属性をマスクすること", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(NewSlogHandler(Config{Format: FormatJSON, Output: &buf, RedactKeys: []string{"email", "password"}}))
+
+		logger.With("Email", "t.tanaka@example.com").Info("メッセージ",
+			slog.Group("user", slog.String("password", "secret"), slog.String("name", "田中")))
+
+		assert.NotContains(t, buf.String(), "t.tanaka@example.com")
+		assert.NotContains(t, buf.String(), "secret")
+		lines := decodeLines(t, &buf)
+		require.Len(t, lines, 1)
+		assert.Equal(t, "[REDACTED]", lines[0]["Email"])
+		assert.Equal(t, map[string]any{"password": "[REDACTED]", "name": "田中"}, lines[0]["user"])
+	})
+}
+
+func TestSlogHandler_Sampling(t *testing.T) {
+	t.Run("Info以下のログを間引くこと", func(t *testing.T) {
+		var buf bytes.Buffer
+		h := NewSlogHandler(Config{Format: FormatJSON, Output: &buf, Sampling: &SamplingConfig{
+			Tick:       time.Minute,
+			Initial:    2,
+			Thereafter: 3,
+		}})
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		h.sampler.now = func() time.Time { return now }
+		l := NewSlogLogger(h)
+
+		// 1、2件目は出力し、以降は3件ごとに出力する（5、8件目）
+		for range 8 {
+			l.Info(context.Background(), "info")
+		}
+		// Warn以上は間引かない
+		for range 3 {
+			l.Warn(context.Background(), "warn")
+		}
+		// 期間が過ぎたらリセットする
+		now = now.Add(time.Minute)
+		l.Info(context.Background(), "info")
+
+		var infos, warns int
+		for _, line := range decodeLines(t, &buf) {
+			switch line["msg"] {
+			case "info":
+				infos++
+			case "warn":
+				warns++
+			}
+		}
+		assert.Equal(t, 5, infos)
+		assert.Equal(t, 3, warns)
+	})
+	t.Run("ハンドラーを共有したロガー間でサンプリングの件数を共有すること", func(t *testing.T) {
+		var buf bytes.Buffer
+		h := NewSlogHandler(Config{Format: FormatJSON, Output: &buf, Sampling: &SamplingConfig{
+			Tick:       time.Minute,
+			Initial:    2,
+			Thereafter: 3,
+		}})
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		h.sampler.now = func() time.Time { return now }
+
+		// 同じハンドラーから作成したロガー（Withで作成した子ロガーを含む）
+		l1 := NewSlogLogger(h)
+		l2 := NewSlogLogger(h).With("component", "middleware")
+		for range 2 {
+			l1.Info(context.Background(), "info")
+			l2.Info(context.Background(), "info")
+		}
+
+		// 合計4件のうち、最初の2件のみ出力する
+		assert.Len(t, decodeLines(t, &buf), 2)
+	})
+	t.Run("サンプリングの対象外のコンテキストの場合は間引かないこと", func(t *testing.T) {
+		var buf bytes.Buffer
+		h := NewSlogHandler(Config{Format: FormatJSON, Output: &buf, Sampling: &SamplingConfig{
+			Tick:       time.Minute,
+			Initial:    1,
+			Thereafter: 0,
+		}})
+		l := NewSlogLogger(h)
+
+		ctx := context.WithValue(context.Background(), contextkey.SkipSampling, true)
+		for range 3 {
+			l.Info(ctx, "access")
+		}
+		// 対象外のログは件数に含めない
+		l.Info(context.Background(), "access")
+		l.Info(context.Background(), "access")
+
+		assert.Len(t, decodeLines(t, &buf), 4)
+	})
+}
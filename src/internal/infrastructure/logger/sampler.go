@@ -0,0 +1,71 @@
+package logger
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// ログのサンプリングの設定
+// 同じレベルとメッセージのログを、Tickの期間ごとに最初のInitial件は全て出力し、以降はThereafter件ごとに1件出力する
+// （アクセスログなど、コンテキストでサンプリングの対象外としたログは間引かない）
+type SamplingConfig struct {
+	Tick       time.Duration
+	Initial    int
+	Thereafter int
+}
+
+type samplingKey struct {
+	level   slog.Level
+	message string
+}
+
+type sampler struct {
+	cfg SamplingConfig
+
+	mu        sync.Mutex
+	tickStart time.Time
+	counts    map[samplingKey]int
+	now       func() time.Time
+}
+
+// cfgがnilの場合はサンプリングしない
+func newSampler(cfg *SamplingConfig) *sampler {
+	if cfg == nil {
+		return nil
+	}
+	return &sampler{
+		cfg:    *cfg,
+		counts: make(map[samplingKey]int),
+		now:    time.Now,
+	}
+}
+
+// ログを出力するかどうか
+func (s *sampler) allow(level slog.Level, message string) bool {
+	if s == nil {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 期間が過ぎたら件数をリセットする
+	now := s.now()
+	if now.Sub(s.tickStart) >= s.cfg.Tick {
+		s.tickStart = now
+		clear(s.counts)
+	}
+
+	key := samplingKey{level: level, message: message}
+	s.counts[key]++
+	n := s.counts[key]
+
+	if n <= s.cfg.Initial {
+		return true
+	}
+	if s.cfg.Thereafter <= 0 {
+		return false
+	}
+	return (n-s.cfg.Initial)%s.cfg.Thereafter == 0
+}
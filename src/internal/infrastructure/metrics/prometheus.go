@@ -0,0 +1,104 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"go-gin-domain/internal/domain/apperror"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Prometheus形式のメトリクス
+type PrometheusMetrics struct {
+	registry         *prometheus.Registry
+	requestsTotal    *prometheus.CounterVec
+	requestDuration  *prometheus.HistogramVec
+	requestsInFlight *prometheus.GaugeVec
+	usecaseCalls     *prometheus.CounterVec
+}
+
+func NewPrometheusMetrics() *PrometheusMetrics {
+	m := &PrometheusMetrics{
+		registry: prometheus.NewRegistry(),
+		requestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Total number of HTTP requests.",
+		}, []string{"method", "route", "status"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request latencies in seconds.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		requestsInFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "http_requests_in_flight",
+			Help: "Number of HTTP requests currently being served.",
+		}, []string{"method", "route"}),
+		usecaseCalls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "usecase_calls_total",
+			Help: "Total number of usecase calls by result.",
+		}, []string{"usecase", "method", "result"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requestsTotal,
+		m.requestDuration,
+		m.requestsInFlight,
+		m.usecaseCalls,
+	)
+
+	return m
+}
+
+// /metrics用のハンドラー
+func (m *PrometheusMetrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *PrometheusMetrics) IncInFlight(method, route string) {
+	m.requestsInFlight.WithLabelValues(method, route).Inc()
+}
+
+func (m *PrometheusMetrics) DecInFlight(method, route string) {
+	m.requestsInFlight.WithLabelValues(method, route).Dec()
+}
+
+func (m *PrometheusMetrics) ObserveRequest(method, route, status string, duration time.Duration) {
+	m.requestsTotal.WithLabelValues(method, route, status).Inc()
+	m.requestDuration.WithLabelValues(method, route, status).Observe(duration.Seconds())
+}
+
+func (m *PrometheusMetrics) ObserveUsecase(usecase, method string, err error) {
+	m.usecaseCalls.WithLabelValues(usecase, method, result(err)).Inc()
+}
+
+// エラーの種類を結果のラベルにする
+func result(err error) string {
+	var errBadRequest *apperror.ErrBadRequest
+	var errValidation *apperror.ErrValidation
+	var errNotFound *apperror.ErrNotFound
+	var errConflict *apperror.ErrConflict
+	var errForbidden *apperror.ErrForbidden
+
+	switch {
+	case err == nil:
+		return "success"
+	case errors.As(err, &errBadRequest):
+		return "bad_request"
+	case errors.As(err, &errValidation):
+		return "validation_error"
+	case errors.As(err, &errNotFound):
+		return "not_found"
+	case errors.As(err, &errConflict):
+		return "conflict"
+	case errors.As(err, &errForbidden):
+		return "forbidden"
+	default:
+		return "error"
+	}
+}
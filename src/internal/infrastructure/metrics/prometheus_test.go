@@ -0,0 +1,47 @@
+//go:build unit
+
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go-gin-domain/internal/domain/apperror"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrometheusMetrics_ObserveUsecase(t *testing.T) {
+	t.Run("エラーの種類ごとに記録すること", func(t *testing.T) {
+		m := NewPrometheusMetrics()
+
+		m.ObserveUsecase("user", "FindByUID", nil)
+		m.ObserveUsecase("user", "FindByUID", &apperror.ErrNotFound{Err: errors.New("not found")})
+		m.ObserveUsecase("user", "FindByUID", &apperror.ErrNotFound{Err: errors.New("not found")})
+		m.ObserveUsecase("user", "Create", apperror.NewErrValidation("email", errors.New("invalid")))
+		m.ObserveUsecase("user", "Create", errors.New("db error"))
+
+		assert.Equal(t, 1.0, testutil.ToFloat64(m.usecaseCalls.WithLabelValues("user", "FindByUID", "success")))
+		assert.Equal(t, 2.0, testutil.ToFloat64(m.usecaseCalls.WithLabelValues("user", "FindByUID", "not_found")))
+		assert.Equal(t, 1.0, testutil.ToFloat64(m.usecaseCalls.WithLabelValues("user", "Create", "validation_error")))
+		assert.Equal(t, 1.0, testutil.ToFloat64(m.usecaseCalls.WithLabelValues("user", "Create", "error")))
+	})
+}
+
+func TestPrometheusMetrics_ObserveRequest(t *testing.T) {
+	t.Run("リクエスト数と処理時間を記録すること", func(t *testing.T) {
+		m := NewPrometheusMetrics()
+
+		m.IncInFlight("GET", "/api/v1/user/:uid")
+		m.ObserveRequest("GET", "/api/v1/user/:uid", "200", 30*time.Millisecond)
+
+		assert.Equal(t, 1.0, testutil.ToFloat64(m.requestsInFlight.WithLabelValues("GET", "/api/v1/user/:uid")))
+		assert.Equal(t, 1.0, testutil.ToFloat64(m.requestsTotal.WithLabelValues("GET", "/api/v1/user/:uid", "200")))
+		assert.Equal(t, 1, testutil.CollectAndCount(m.requestDuration))
+
+		m.DecInFlight("GET", "/api/v1/user/:uid")
+		assert.Equal(t, 0.0, testutil.ToFloat64(m.requestsInFlight.WithLabelValues("GET", "/api/v1/user/:uid")))
+	})
+}
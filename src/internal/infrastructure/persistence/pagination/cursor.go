@@ -0,0 +1,42 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"go-gin-domain/internal/domain/repository"
+)
+
+// キーセットページネーション用のカーソル。
+// 並び替え項目の値とIDの組み合わせで次のページの開始位置を表す。
+type Cursor struct {
+	Sort  string `json:"s"`
+	Order string `json:"o"`
+	Value string `json:"v"`
+	ID    int64  `json:"id"`
+}
+
+// クライアントには不透明な文字列として返す
+func Encode(c Cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// 並び替え条件が一致しないカーソルは不正とする
+func Decode(value, sort, order string) (Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return Cursor{}, &repository.ErrInvalidCursor{}
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return Cursor{}, &repository.ErrInvalidCursor{}
+	}
+
+	if c.Sort != sort || c.Order != order || c.ID <= 0 {
+		return Cursor{}, &repository.ErrInvalidCursor{}
+	}
+
+	return c, nil
+}
@@ -0,0 +1,44 @@
+//go:build unit
+
+package pagination
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"go-gin-domain/internal/domain/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("エンコードしたカーソルをデコードできること", func(t *testing.T) {
+		cursor := Cursor{Sort: "created_at", Order: "desc", Value: "2025-01-01T00:00:00Z", ID: 10}
+
+		// テストの実行
+		decoded, err := Decode(Encode(cursor), "created_at", "desc")
+
+		// 検証
+		assert.NoError(t, err)
+		assert.Equal(t, cursor, decoded)
+	})
+
+	t.Run("不正なカーソルの場合にエラーを返すこと", func(t *testing.T) {
+		tests := map[string]string{
+			"base64でない":  "!!!",
+			"JSONでない":    base64.RawURLEncoding.EncodeToString([]byte("xxxxxx")),
+			"並び替え項目が異なる": Encode(Cursor{Sort: "email", Order: "asc", ID: 1}),
+			"並び順が異なる":    Encode(Cursor{Sort: "id", Order: "desc", ID: 1}),
+			"IDが0":       Encode(Cursor{Sort: "id", Order: "asc", ID: 0}),
+		}
+
+		for name, value := range tests {
+			// テストの実行
+			_, err := Decode(value, "id", "asc")
+
+			// 検証
+			var errInvalidCursor *repository.ErrInvalidCursor
+			assert.ErrorAs(t, err, &errInvalidCursor, name)
+		}
+	})
+}
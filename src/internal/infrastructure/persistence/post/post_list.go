@@ -0,0 +1,41 @@
+package post
+
+import (
+	"go-gin-domain/internal/domain/apperror"
+	domain "go-gin-domain/internal/domain/post"
+	"go-gin-domain/internal/infrastructure/persistence/pagination"
+)
+
+// 並び順はID順で固定のため、カーソルはIDのみを利用する
+const (
+	cursorSort  = "id"
+	cursorOrder = "asc"
+)
+
+// 不正なカーソルは不正なリクエストのエラーとして返す
+func decodeCursor(value string) (pagination.Cursor, error) {
+	c, err := pagination.Decode(value, cursorSort, cursorOrder)
+	if err != nil {
+		return pagination.Cursor{}, &apperror.ErrBadRequest{Err: err}
+	}
+	return c, nil
+}
+
+// 1件多く取得した結果から、次のページの有無とカーソルを設定する
+func newFindAllResult(posts []*domain.Post, params domain.FindAllParams) *domain.FindAllResult {
+	result := &domain.FindAllResult{Posts: posts}
+	if len(posts) <= params.Limit {
+		return result
+	}
+
+	result.Posts = posts[:params.Limit]
+	last := result.Posts[len(result.Posts)-1]
+	result.HasMore = true
+	result.NextCursor = pagination.Encode(pagination.Cursor{
+		Sort:  cursorSort,
+		Order: cursorOrder,
+		ID:    last.ID(),
+	})
+
+	return result
+}
@@ -2,9 +2,16 @@ package post
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	logger_usecase "go-gin-domain/internal/application/usecase/logger"
 	domain "go-gin-domain/internal/domain/post"
+	"go-gin-domain/internal/domain/repository"
+	"go-gin-domain/internal/infrastructure/database"
 )
 
 type postRepository struct {
@@ -17,32 +24,170 @@ func NewPostRepository(logger logger_usecase.Logger) domain.PostRepository {
 	}
 }
 
-func (r *postRepository) Create(ctx context.Context, db string, post *domain.Post) (*domain.Post, error) {
-	// DBへの登録処理をした後に戻り値を返す想定
-	return post, nil
+// 取得対象のカラム（scanPostの順序と合わせること）
+const postColumns = `id, text, author_uid, created_at, updated_at, deleted_at`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPost(row rowScanner) (*domain.Post, error) {
+	var id int64
+	var text string
+	var authorUID sql.NullString
+	var createdAt, updatedAt time.Time
+	var deletedAt sql.NullTime
+	if err := row.Scan(&id, &text, &authorUID, &createdAt, &updatedAt, &deletedAt); err != nil {
+		return nil, err
+	}
+
+	var deletedAtPtr *time.Time
+	if deletedAt.Valid {
+		deletedAtPtr = &deletedAt.Time
+	}
+
+	// 値のチェックは不要とし、DBから復元するためのコンストラクタを利用
+	return domain.ReconstitutePost(id, text, authorUID.String, createdAt, updatedAt, deletedAtPtr), nil
+}
+
+func (r *postRepository) Create(ctx context.Context, db repository.DB, post *domain.Post) (*domain.Post, error) {
+	query := `
+		INSERT INTO posts (text, author_uid)
+		VALUES ($1, $2)
+		RETURNING ` + postColumns
+
+	authorUID := sql.NullString{String: post.AuthorUID(), Valid: post.AuthorUID() != ""}
+	conn, err := database.AsConn(db)
+	if err != nil {
+		return nil, err
+	}
+	createPost, err := scanPost(conn.QueryRowContext(ctx, query, post.TextValue(), authorUID))
+	if err != nil {
+		return nil, r.logError(ctx, "Postの登録に失敗しました。", err)
+	}
+
+	return createPost, nil
 }
 
-func (r *postRepository) FindAll(ctx context.Context, db string) ([]*domain.Post, error) {
-	// DBからPostデータを取得したことを想定として固定値を定義
-	dbPosts := []struct {
-		Text string
-	}{
-		{
-			Text: "テキスト１",
-		},
-		{
-			Text: "テキスト２",
-		},
+func (r *postRepository) FindAll(ctx context.Context, db repository.DB, params domain.FindAllParams) (*domain.FindAllResult, error) {
+	params = params.Normalize()
+
+	// 検索条件の設定
+	// 論理削除済みのPostは除外
+	conditions := []string{"deleted_at IS NULL"}
+	var args []any
+	addArg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if params.AuthorUID != "" {
+		conditions = append(conditions, "author_uid = "+addArg(params.AuthorUID))
+	}
+	if !params.CreatedAfter.IsZero() {
+		conditions = append(conditions, "created_at > "+addArg(params.CreatedAfter))
 	}
+	if !params.CreatedBefore.IsZero() {
+		conditions = append(conditions, "created_at < "+addArg(params.CreatedBefore))
+	}
+	if params.Cursor != "" {
+		cursor, err := decodeCursor(params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, "id > "+addArg(cursor.ID))
+	}
+
+	query := `
+		SELECT ` + postColumns + `
+		FROM posts
+		WHERE ` + strings.Join(conditions, " AND ")
+	// 次のページの有無を判定するため1件多く取得する
+	query += `
+		ORDER BY id
+		LIMIT ` + addArg(params.Limit+1)
+
+	conn, err := database.AsConn(db)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, r.logError(ctx, "Post一覧の取得に失敗しました。", err)
+	}
+	defer rows.Close()
 
 	// Postエンティティを利用してスライスを定義
-	posts := make([]*domain.Post, 0, len(dbPosts))
+	posts := []*domain.Post{}
 
 	// ループ処理でPostエンティティのスライスへ変換
-	for _, dbPost := range dbPosts {
-		// 値のチェックは不要とし、DBから復元するためのコンストラクタを利用
-		posts = append(posts, domain.ReconstitutePost(dbPost.Text))
+	for rows.Next() {
+		post, err := scanPost(rows)
+		if err != nil {
+			return nil, r.logError(ctx, "Post一覧の取得に失敗しました。", err)
+		}
+		posts = append(posts, post)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.logError(ctx, "Post一覧の取得に失敗しました。", err)
+	}
+
+	return newFindAllResult(posts, params), nil
+}
+
+func (r *postRepository) FindByID(ctx context.Context, db repository.DB, id int64) (*domain.Post, error) {
+	return r.findByID(ctx, db, id, "")
+}
+
+func (r *postRepository) FindByIDForUpdate(ctx context.Context, db repository.DB, id int64) (*domain.Post, error) {
+	return r.findByID(ctx, db, id, "FOR UPDATE")
+}
+
+func (r *postRepository) findByID(ctx context.Context, db repository.DB, id int64, lock string) (*domain.Post, error) {
+	query := `
+		SELECT ` + postColumns + `
+		FROM posts
+		WHERE id = $1
+		AND deleted_at IS NULL
+		` + lock
+
+	conn, err := database.AsConn(db)
+	if err != nil {
+		return nil, err
 	}
+	post, err := scanPost(conn.QueryRowContext(ctx, query, id))
+	if err != nil {
+		// 対象のPostが存在しない場合はnilを返す
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, r.logError(ctx, "Postの取得に失敗しました。", err)
+	}
+
+	return post, nil
+}
+
+func (r *postRepository) Save(ctx context.Context, db repository.DB, post *domain.Post) (*domain.Post, error) {
+	query := `
+		UPDATE posts
+		SET text = $2, updated_at = $3, deleted_at = $4
+		WHERE id = $1
+		RETURNING ` + postColumns
+
+	conn, err := database.AsConn(db)
+	if err != nil {
+		return nil, err
+	}
+	row := conn.QueryRowContext(ctx, query, post.ID(), post.TextValue(), post.UpdatedAt(), post.DeletedAt())
+	savePost, err := scanPost(row)
+	if err != nil {
+		return nil, r.logError(ctx, "Postの更新に失敗しました。", err)
+	}
+
+	return savePost, nil
+}
 
-	return posts, nil
+// エラーログを出力し、メッセージを付与したエラーを返す
+func (r *postRepository) logError(ctx context.Context, msg string, err error) error {
+	r.logger.Error(ctx, msg, "error", err)
+	return fmt.Errorf("%s: %w", msg, err)
 }
@@ -0,0 +1,183 @@
+package post
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	logger_usecase "go-gin-domain/internal/application/usecase/logger"
+	domain "go-gin-domain/internal/domain/post"
+	"go-gin-domain/internal/domain/repository"
+	"go-gin-domain/internal/infrastructure/database"
+)
+
+// 保存用のレコード（Postエンティティはフィールドがプライベートのため値を保持する）
+type postRecord struct {
+	id        int64
+	text      string
+	authorUID string
+	createdAt time.Time
+	updatedAt time.Time
+	deletedAt *time.Time
+}
+
+func (p postRecord) toPost() *domain.Post {
+	var deletedAt *time.Time
+	if p.deletedAt != nil {
+		d := *p.deletedAt
+		deletedAt = &d
+	}
+
+	// 値のチェックは不要とし、DBから復元するためのコンストラクタを利用
+	return domain.ReconstitutePost(p.id, p.text, p.authorUID, p.createdAt, p.updatedAt, deletedAt)
+}
+
+type memoryPostRepository struct {
+	mu     sync.RWMutex
+	nextID int64
+	// 登録順（ID順）に保持する
+	posts  []postRecord
+	logger logger_usecase.Logger
+}
+
+// インメモリのリポジトリ（ローカル開発およびテスト用）
+// トランザクション内の変更は、ロールバック時に元に戻す処理をトランザクションに登録する
+func NewMemoryPostRepository(logger logger_usecase.Logger) domain.PostRepository {
+	return &memoryPostRepository{
+		nextID: 1,
+		posts:  []postRecord{},
+		logger: logger,
+	}
+}
+
+// インメモリのリポジトリはDB接続を利用しないため、dbはトランザクションの判定にのみ利用する
+func (r *memoryPostRepository) Create(ctx context.Context, db repository.DB, post *domain.Post) (*domain.Post, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	record := postRecord{
+		id:        r.nextID,
+		text:      post.TextValue(),
+		authorUID: post.AuthorUID(),
+		createdAt: now,
+		updatedAt: now,
+	}
+	r.posts = append(r.posts, record)
+	r.nextID++
+
+	// ロールバック時は登録したPostのみ削除する（IDは再利用しない）
+	database.OnRollback(db, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if i, ok := r.index(record.id); ok {
+			r.posts = append(r.posts[:i], r.posts[i+1:]...)
+		}
+	})
+
+	return record.toPost(), nil
+}
+
+func (r *memoryPostRepository) FindAll(ctx context.Context, db repository.DB, params domain.FindAllParams) (*domain.FindAllResult, error) {
+	params = params.Normalize()
+
+	var afterID int64
+	if params.Cursor != "" {
+		cursor, err := decodeCursor(params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		afterID = cursor.ID
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// 次のページの有無を判定するため1件多く取得する
+	posts := []*domain.Post{}
+	for _, p := range r.posts {
+		if len(posts) > params.Limit {
+			break
+		}
+		// 論理削除済みのPostは除外
+		if p.id <= afterID || p.deletedAt != nil {
+			continue
+		}
+		if params.AuthorUID != "" && p.authorUID != params.AuthorUID {
+			continue
+		}
+		if !params.CreatedAfter.IsZero() && !p.createdAt.After(params.CreatedAfter) {
+			continue
+		}
+		if !params.CreatedBefore.IsZero() && !p.createdAt.Before(params.CreatedBefore) {
+			continue
+		}
+		posts = append(posts, p.toPost())
+	}
+
+	return newFindAllResult(posts, params), nil
+}
+
+func (r *memoryPostRepository) FindByID(ctx context.Context, db repository.DB, id int64) (*domain.Post, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if i, ok := r.index(id); ok {
+		if p := r.posts[i]; p.deletedAt == nil {
+			return p.toPost(), nil
+		}
+	}
+
+	// 対象のPostが存在しない場合はnilを返す
+	return nil, nil
+}
+
+// トランザクション同士はトランザクション管理で直列に実行されるため、ロックは不要
+func (r *memoryPostRepository) FindByIDForUpdate(ctx context.Context, db repository.DB, id int64) (*domain.Post, error) {
+	return r.FindByID(ctx, db, id)
+}
+
+func (r *memoryPostRepository) Save(ctx context.Context, db repository.DB, post *domain.Post) (*domain.Post, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := post.ID()
+	i, ok := r.index(id)
+	if !ok {
+		err := fmt.Errorf("Postの更新に失敗しました。: 対象のPostが存在しません。: ID=%d", id)
+		r.logger.Error(ctx, "Postの更新に失敗しました。", "postId", id, "error", err)
+		return nil, err
+	}
+
+	// 投稿者と作成日時は更新しない
+	current := r.posts[i]
+	record := current
+	record.text = post.TextValue()
+	record.updatedAt = post.UpdatedAt()
+	record.deletedAt = nil
+	if post.DeletedAt() != nil {
+		deletedAt := *post.DeletedAt()
+		record.deletedAt = &deletedAt
+	}
+	r.posts[i] = record
+
+	// ロールバック時は更新前の状態に戻す
+	database.OnRollback(db, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if i, ok := r.index(id); ok {
+			r.posts[i] = current
+		}
+	})
+
+	return record.toPost(), nil
+}
+
+// IDに対応するスライスの位置を返す（ロックを取得して呼び出す）
+// ロールバックでIDに欠番が生じるため、ID順に並んでいることを利用して二分探索する
+func (r *memoryPostRepository) index(id int64) (int, bool) {
+	i := sort.Search(len(r.posts), func(i int) bool { return r.posts[i].id >= id })
+	return i, i < len(r.posts) && r.posts[i].id == id
+}
@@ -0,0 +1,231 @@
+//go:build unit
+
+package post
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	mockLogger "go-gin-domain/internal/application/usecase/logger/mock_logger"
+	"go-gin-domain/internal/domain/apperror"
+	domain "go-gin-domain/internal/domain/post"
+	"go-gin-domain/internal/domain/repository"
+	"go-gin-domain/internal/infrastructure/database"
+	"go-gin-domain/internal/infrastructure/persistence/pagination"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testAuthorUID      = "0196f1c2-7a3b-7c4d-8e5f-000000000021"
+	testOtherAuthorUID = "0196f1c2-7a3b-7c4d-8e5f-000000000022"
+)
+
+func newTestPost(t *testing.T, text string) *domain.Post {
+	return newTestPostBy(t, testAuthorUID, text)
+}
+
+func newTestPostBy(t *testing.T, authorUID, text string) *domain.Post {
+	t.Helper()
+	postText, err := domain.NewText(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	post, err := domain.NewPost(authorUID, postText)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return post
+}
+
+func TestMemoryPostRepository_Rollback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	t.Run("ロールバック中のトランザクション外の登録が取り消されず、IDが再利用されないこと", func(t *testing.T) {
+		repo := NewMemoryPostRepository(mockLogger.NewMockLogger(ctrl))
+		txManager := database.NewMemoryTxManager()
+
+		// 処理実行（トランザクションの実行中に、トランザクション外で別のPostを登録する）
+		var txPostID int64
+		err := txManager.Do(ctx, func(ctx context.Context, tx repository.DB) error {
+			post, err := repo.Create(ctx, tx, newTestPost(t, "tx"))
+			if err != nil {
+				return err
+			}
+			txPostID = post.ID()
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := repo.Create(ctx, nil, newTestPost(t, "non-tx"))
+				assert.NoError(t, err)
+			}()
+			wg.Wait()
+
+			return fmt.Errorf("Internal Server Error")
+		})
+		assert.Error(t, err)
+
+		post, err := repo.Create(ctx, nil, newTestPost(t, "after"))
+		assert.NoError(t, err)
+
+		// 検証
+		assert.NotEqual(t, txPostID, post.ID())
+
+		result, err := repo.FindAll(ctx, nil, domain.FindAllParams{})
+		assert.NoError(t, err)
+		texts := []string{}
+		for _, p := range result.Posts {
+			texts = append(texts, p.TextValue())
+		}
+		assert.Equal(t, []string{"non-tx", "after"}, texts)
+	})
+
+	t.Run("ロールバックした更新が元に戻ること", func(t *testing.T) {
+		repo := NewMemoryPostRepository(mockLogger.NewMockLogger(ctrl))
+		txManager := database.NewMemoryTxManager()
+		created, err := repo.Create(ctx, nil, newTestPost(t, "before"))
+		assert.NoError(t, err)
+
+		// 処理実行
+		err = txManager.Do(ctx, func(ctx context.Context, tx repository.DB) error {
+			post, err := repo.FindByIDForUpdate(ctx, tx, created.ID())
+			if err != nil {
+				return err
+			}
+			post.UpdateText(domain.ReconstituteText("after"))
+			if _, err := repo.Save(ctx, tx, post); err != nil {
+				return err
+			}
+			return fmt.Errorf("Internal Server Error")
+		})
+
+		// 検証
+		assert.Error(t, err)
+
+		post, err := repo.FindByID(ctx, nil, created.ID())
+		assert.NoError(t, err)
+		assert.Equal(t, "before", post.TextValue())
+	})
+}
+
+func TestMemoryPostRepository_FindAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	t.Run("ページをまたいでもID順に重複なく取得できること", func(t *testing.T) {
+		repo := NewMemoryPostRepository(mockLogger.NewMockLogger(ctrl))
+		for i := 1; i <= 5; i++ {
+			_, err := repo.Create(ctx, nil, newTestPost(t, fmt.Sprintf("post%d", i)))
+			assert.NoError(t, err)
+		}
+
+		// テストの実行（1ページ目の取得後に登録されたPostは後ろに追加される）
+		ids := []int64{}
+		params := domain.FindAllParams{Limit: 2}
+		for page := 1; ; page++ {
+			result, err := repo.FindAll(ctx, nil, params)
+			assert.NoError(t, err)
+			for _, p := range result.Posts {
+				ids = append(ids, p.ID())
+			}
+			if page == 1 {
+				_, err := repo.Create(ctx, nil, newTestPost(t, "post6"))
+				assert.NoError(t, err)
+			}
+			if !result.HasMore {
+				assert.Empty(t, result.NextCursor)
+				break
+			}
+			assert.NotEmpty(t, result.NextCursor)
+			params.Cursor = result.NextCursor
+		}
+
+		// 検証
+		assert.Equal(t, []int64{1, 2, 3, 4, 5, 6}, ids)
+	})
+
+	t.Run("投稿者と作成日時で絞り込めること", func(t *testing.T) {
+		repo := NewMemoryPostRepository(mockLogger.NewMockLogger(ctrl))
+		before := time.Now().Add(-time.Second)
+		_, err := repo.Create(ctx, nil, newTestPostBy(t, testAuthorUID, "author1"))
+		assert.NoError(t, err)
+		_, err = repo.Create(ctx, nil, newTestPostBy(t, testOtherAuthorUID, "author2"))
+		assert.NoError(t, err)
+		after := time.Now().Add(time.Second)
+
+		// テストの実行
+		byAuthor, err := repo.FindAll(ctx, nil, domain.FindAllParams{AuthorUID: testOtherAuthorUID})
+		assert.NoError(t, err)
+		inWindow, err := repo.FindAll(ctx, nil, domain.FindAllParams{CreatedAfter: before, CreatedBefore: after})
+		assert.NoError(t, err)
+		outOfWindow, err := repo.FindAll(ctx, nil, domain.FindAllParams{CreatedAfter: after})
+		assert.NoError(t, err)
+
+		// 検証
+		assert.Len(t, byAuthor.Posts, 1)
+		assert.Equal(t, testOtherAuthorUID, byAuthor.Posts[0].AuthorUID())
+		assert.Len(t, inWindow.Posts, 2)
+		assert.Empty(t, outOfWindow.Posts)
+	})
+
+	t.Run("カーソルが不正な場合にエラーを返すこと", func(t *testing.T) {
+		repo := NewMemoryPostRepository(mockLogger.NewMockLogger(ctrl))
+		cursors := []string{
+			"xxxxxx",
+			// 並び替え条件が一致しない（userの一覧のカーソル）
+			pagination.Encode(pagination.Cursor{Sort: "created_at", Order: "asc", Value: "2025-01-01T00:00:00Z", ID: 1}),
+		}
+
+		for _, cursor := range cursors {
+			// テストの実行
+			result, err := repo.FindAll(ctx, nil, domain.FindAllParams{Cursor: cursor})
+
+			// 検証
+			assert.Nil(t, result)
+			var errBadRequest *apperror.ErrBadRequest
+			assert.ErrorAs(t, err, &errBadRequest, cursor)
+		}
+	})
+}
+
+func TestMemoryPostRepository_SoftDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	t.Run("論理削除したPostは取得できないこと", func(t *testing.T) {
+		repo := NewMemoryPostRepository(mockLogger.NewMockLogger(ctrl))
+		created, err := repo.Create(ctx, nil, newTestPost(t, "deleted"))
+		assert.NoError(t, err)
+
+		// テストの実行
+		created.Delete()
+		_, err = repo.Save(ctx, nil, created)
+		assert.NoError(t, err)
+
+		// 検証
+		post, err := repo.FindByID(ctx, nil, created.ID())
+		assert.NoError(t, err)
+		assert.Nil(t, post)
+
+		post, err = repo.FindByIDForUpdate(ctx, nil, created.ID())
+		assert.NoError(t, err)
+		assert.Nil(t, post)
+
+		result, err := repo.FindAll(ctx, nil, domain.FindAllParams{})
+		assert.NoError(t, err)
+		assert.Empty(t, result.Posts)
+	})
+}
@@ -0,0 +1,70 @@
+package post
+
+import (
+	"context"
+
+	"go-gin-domain/internal/application/usecase/tracing"
+	domain "go-gin-domain/internal/domain/post"
+	"go-gin-domain/internal/domain/repository"
+
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("go-gin-domain/internal/infrastructure/persistence/post")
+
+// トレースのスパンを記録するためのラッパー
+type tracingPostRepository struct {
+	next domain.PostRepository
+}
+
+// 各メソッドの呼び出しをスパンとして記録する
+func NewTracingPostRepository(next domain.PostRepository) domain.PostRepository {
+	return &tracingPostRepository{
+		next: next,
+	}
+}
+
+func (r *tracingPostRepository) Create(ctx context.Context, db repository.DB, post *domain.Post) (*domain.Post, error) {
+	ctx, span := tracer.Start(ctx, "PostRepository.Create")
+	defer span.End()
+
+	result, err := r.next.Create(ctx, db, post)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (r *tracingPostRepository) FindAll(ctx context.Context, db repository.DB, params domain.FindAllParams) (*domain.FindAllResult, error) {
+	ctx, span := tracer.Start(ctx, "PostRepository.FindAll")
+	defer span.End()
+
+	result, err := r.next.FindAll(ctx, db, params)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (r *tracingPostRepository) FindByID(ctx context.Context, db repository.DB, id int64) (*domain.Post, error) {
+	ctx, span := tracer.Start(ctx, "PostRepository.FindByID")
+	defer span.End()
+
+	result, err := r.next.FindByID(ctx, db, id)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (r *tracingPostRepository) FindByIDForUpdate(ctx context.Context, db repository.DB, id int64) (*domain.Post, error) {
+	ctx, span := tracer.Start(ctx, "PostRepository.FindByIDForUpdate")
+	defer span.End()
+
+	result, err := r.next.FindByIDForUpdate(ctx, db, id)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (r *tracingPostRepository) Save(ctx context.Context, db repository.DB, post *domain.Post) (*domain.Post, error) {
+	ctx, span := tracer.Start(ctx, "PostRepository.Save")
+	defer span.End()
+
+	result, err := r.next.Save(ctx, db, post)
+	tracing.RecordError(span, err)
+	return result, err
+}
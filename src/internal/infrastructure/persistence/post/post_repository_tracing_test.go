@@ -0,0 +1,158 @@
+//go:build unit
+
+package post
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain "go-gin-domain/internal/domain/post"
+	mockPost "go-gin-domain/internal/domain/post/mock_post_repository"
+	"go-gin-domain/internal/domain/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+)
+
+// 終了したスパンを記録する（パッケージのtracerは最初に設定したプロバイダーを使い続けるため、1度だけ設定する）
+var spanRecorder = func() *tracetest.SpanRecorder {
+	sr := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	return sr
+}()
+
+func TestTracingPostRepository(t *testing.T) {
+	// リポジトリのモック
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mockPost.NewMockPostRepository(ctrl)
+
+	repo := NewTracingPostRepository(mockRepo)
+
+	// 呼び出し元のスパン
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	defer parent.End()
+
+	// 次の処理に渡されたコンテキストのスパン
+	var nextSpan trace.SpanContext
+
+	post := domain.ReconstitutePost(1, "こんにちは", "xxxx-xxxx-xxxx-0001", time.Time{}, time.Time{}, nil)
+
+	tests := []struct {
+		spanName string
+		// errを返すようにモック化して呼び出す
+		call func(err error) error
+	}{
+		{
+			spanName: "PostRepository.Create",
+			call: func(err error) error {
+				mockRepo.EXPECT().Create(gomock.Any(), nil, post).DoAndReturn(
+					func(ctx context.Context, _ repository.DB, _ *domain.Post) (*domain.Post, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = repo.Create(ctx, nil, post)
+				return err
+			},
+		},
+		{
+			spanName: "PostRepository.FindAll",
+			call: func(err error) error {
+				mockRepo.EXPECT().FindAll(gomock.Any(), nil, domain.FindAllParams{}).DoAndReturn(
+					func(ctx context.Context, _ repository.DB, _ domain.FindAllParams) (*domain.FindAllResult, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = repo.FindAll(ctx, nil, domain.FindAllParams{})
+				return err
+			},
+		},
+		{
+			spanName: "PostRepository.FindByID",
+			call: func(err error) error {
+				mockRepo.EXPECT().FindByID(gomock.Any(), nil, int64(1)).DoAndReturn(
+					func(ctx context.Context, _ repository.DB, _ int64) (*domain.Post, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = repo.FindByID(ctx, nil, 1)
+				return err
+			},
+		},
+		{
+			spanName: "PostRepository.FindByIDForUpdate",
+			call: func(err error) error {
+				mockRepo.EXPECT().FindByIDForUpdate(gomock.Any(), nil, int64(1)).DoAndReturn(
+					func(ctx context.Context, _ repository.DB, _ int64) (*domain.Post, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = repo.FindByIDForUpdate(ctx, nil, 1)
+				return err
+			},
+		},
+		{
+			spanName: "PostRepository.Save",
+			call: func(err error) error {
+				mockRepo.EXPECT().Save(gomock.Any(), nil, post).DoAndReturn(
+					func(ctx context.Context, _ repository.DB, _ *domain.Post) (*domain.Post, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = repo.Save(ctx, nil, post)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spanName+"のスパンを呼び出し元の子として記録すること", func(t *testing.T) {
+			spanRecorder.Reset()
+
+			// テストの実行
+			err := tt.call(nil)
+
+			// 検証
+			assert.NoError(t, err)
+			spans := spanRecorder.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			assert.Equal(t, tt.spanName, span.Name())
+			assert.Equal(t, parent.SpanContext().TraceID(), span.SpanContext().TraceID())
+			assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+			assert.Equal(t, span.SpanContext().SpanID(), nextSpan.SpanID())
+			assert.Equal(t, codes.Unset, span.Status().Code)
+		})
+
+		t.Run(tt.spanName+"でエラーの場合にスパンにエラーを記録すること", func(t *testing.T) {
+			spanRecorder.Reset()
+
+			// テストの実行
+			err := tt.call(errors.New("Internal Server Error"))
+
+			// 検証
+			assert.Error(t, err)
+			spans := spanRecorder.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			assert.Equal(t, tt.spanName, span.Name())
+			assert.Equal(t, codes.Error, span.Status().Code)
+			assert.Equal(t, "Internal Server Error", span.Status().Description)
+			require.Len(t, span.Events(), 1)
+			assert.Equal(t, "exception", span.Events()[0].Name)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package user
+
+import (
+	"time"
+
+	"go-gin-domain/internal/domain/apperror"
+	"go-gin-domain/internal/domain/repository"
+	domain "go-gin-domain/internal/domain/user"
+	"go-gin-domain/internal/infrastructure/persistence/pagination"
+)
+
+// デコード済みのカーソル
+type userCursor struct {
+	pagination.Cursor
+	CreatedAt time.Time
+}
+
+// 不正なカーソルは不正なリクエストのエラーとして返す
+func decodeCursor(params domain.FindAllParams) (userCursor, error) {
+	c, err := pagination.Decode(params.Cursor, string(params.SortField), string(params.SortOrder))
+	if err != nil {
+		return userCursor{}, &apperror.ErrBadRequest{Err: err}
+	}
+
+	cursor := userCursor{Cursor: c}
+	if params.SortField == domain.SortByCreatedAt {
+		createdAt, err := time.Parse(time.RFC3339Nano, c.Value)
+		if err != nil {
+			return userCursor{}, &apperror.ErrBadRequest{Err: &repository.ErrInvalidCursor{}}
+		}
+		cursor.CreatedAt = createdAt
+	}
+
+	return cursor, nil
+}
+
+// 1件多く取得した結果から、次のページの有無とカーソルを設定する
+func newFindAllResult(users []*domain.User, params domain.FindAllParams) *domain.FindAllResult {
+	result := &domain.FindAllResult{Users: users}
+	if len(users) <= params.Limit {
+		return result
+	}
+
+	result.Users = users[:params.Limit]
+	last := result.Users[len(result.Users)-1]
+	result.HasMore = true
+	result.NextCursor = pagination.Encode(pagination.Cursor{
+		Sort:  string(params.SortField),
+		Order: string(params.SortOrder),
+		Value: sortValue(last, params.SortField),
+		ID:    last.ID,
+	})
+
+	return result
+}
+
+// 並び替え項目の値
+func sortValue(user *domain.User, field domain.SortField) string {
+	switch field {
+	case domain.SortByLastName:
+		return user.LastName
+	case domain.SortByEmail:
+		return user.Email
+	default:
+		return user.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+}
@@ -2,13 +2,22 @@ package user
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
-	"time"
+	"strings"
 
 	logger_usecase "go-gin-domain/internal/application/usecase/logger"
+	"go-gin-domain/internal/domain/repository"
 	domain "go-gin-domain/internal/domain/user"
+	"go-gin-domain/internal/infrastructure/database"
+
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// 取得対象のカラム
+const userColumns = "id, uid, last_name, first_name, email, role, created_at, updated_at, deleted_at"
+
 type userRepository struct {
 	logger logger_usecase.Logger
 }
@@ -19,83 +28,263 @@ func NewUserRepository(logger logger_usecase.Logger) domain.UserRepository {
 	}
 }
 
-func (r *userRepository) Create(ctx context.Context, db string, user *domain.User) (*domain.User, error) {
-	// 戻り値の例
-	createUser := &domain.User{
-		ID:        1,
-		UID:       user.UID,
-		LastName:  user.LastName,
-		FirstName: user.FirstName,
-		Email:     user.Email,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-		DeletedAt: nil,
-	}
+func (r *userRepository) Create(ctx context.Context, db repository.DB, user *domain.User) (*domain.User, error) {
+	query := `
+		INSERT INTO users (uid, last_name, first_name, email, role)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + userColumns
 
-	dummy := &domain.User{}
-	if createUser == dummy {
-		msg := fmt.Sprintf("[%s] user is nil", db)
-		r.logger.Error(ctx, msg)
+	conn, err := database.AsConn(db)
+	if err != nil {
+		return nil, err
+	}
+	row := conn.QueryRowContext(ctx, query, user.UID, user.LastName, user.FirstName, user.Email, user.Role)
+	createUser, err := scanUser(row)
+	if err != nil {
+		if isEmailConflict(err) {
+			return nil, domain.NewErrEmailAlreadyExists()
+		}
+		return nil, r.logError(ctx, "ユーザーの登録に失敗しました。", err)
 	}
 
 	return createUser, nil
 }
 
-func (r *userRepository) FindAll(ctx context.Context, db string) ([]*domain.User, error) {
-	// 戻り値の例
-	users := []*domain.User{
-		{
-			ID:        1,
-			UID:       "xxxx-xxxx-xxxx-0001",
-			LastName:  "田中",
-			FirstName: "太郎",
-			Email:     "t.tanaka@example.com",
-			CreatedAt: time.Time{},
-			UpdatedAt: time.Time{},
-			DeletedAt: nil,
-		},
-		{
-			ID:        2,
-			UID:       "xxxx-xxxx-xxxx-0002",
-			LastName:  "佐藤",
-			FirstName: "一郎",
-			Email:     "i.satou@example.com",
-			CreatedAt: time.Time{},
-			UpdatedAt: time.Time{},
-			DeletedAt: nil,
-		},
-	}
-
-	if len(users) == 0 {
-		msg := fmt.Sprintf("[%s] users is nil", db)
-		r.logger.Error(ctx, msg)
-	}
-
-	return users, nil
+func (r *userRepository) FindAll(ctx context.Context, db repository.DB, params domain.FindAllParams) (*domain.FindAllResult, error) {
+	params = params.Normalize()
+
+	// 並び替え項目のカラム（SQLインジェクション対策のため固定の値から選択）
+	column, ok := sortColumns[params.SortField]
+	if !ok {
+		return nil, fmt.Errorf("並び替え項目が不正です。: %s", params.SortField)
+	}
+	direction, operator := "ASC", ">"
+	if params.SortOrder == domain.SortDesc {
+		direction, operator = "DESC", "<"
+	}
+
+	// 検索条件の設定
+	var conditions []string
+	var args []any
+	addArg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if !params.IncludeDeleted {
+		conditions = append(conditions, "deleted_at IS NULL")
+	}
+	if params.EmailPrefix != "" {
+		arg := addArg(escapeLike(params.EmailPrefix) + "%")
+		conditions = append(conditions, fmt.Sprintf(`email ILIKE %s ESCAPE '\'`, arg))
+	}
+	if params.NameContains != "" {
+		arg := addArg("%" + escapeLike(params.NameContains) + "%")
+		conditions = append(conditions, fmt.Sprintf(`(last_name ILIKE %s ESCAPE '\' OR first_name ILIKE %s ESCAPE '\')`, arg, arg))
+	}
+	if params.Cursor != "" {
+		cursor, err := decodeCursor(params)
+		if err != nil {
+			return nil, err
+		}
+
+		// 前回の最後の行より後ろ（同じ値の場合はIDで判定）
+		var value any = cursor.Value
+		if params.SortField == domain.SortByCreatedAt {
+			value = cursor.CreatedAt
+		}
+		conditions = append(conditions, fmt.Sprintf("(%s, id) %s (%s, %s)", column, operator, addArg(value), addArg(cursor.ID)))
+	}
+
+	query := `
+		SELECT ` + userColumns + `
+		FROM users`
+	if len(conditions) > 0 {
+		query += `
+		WHERE ` + strings.Join(conditions, " AND ")
+	}
+	// 次のページの有無を判定するため1件多く取得する
+	query += fmt.Sprintf(`
+		ORDER BY %s %s, id %s
+		LIMIT %s`, column, direction, direction, addArg(params.Limit+1))
+
+	conn, err := database.AsConn(db)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, r.logError(ctx, "ユーザー一覧の取得に失敗しました。", err)
+	}
+	defer rows.Close()
+
+	users := []*domain.User{}
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, r.logError(ctx, "ユーザー一覧の取得に失敗しました。", err)
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.logError(ctx, "ユーザー一覧の取得に失敗しました。", err)
+	}
+
+	return newFindAllResult(users, params), nil
 }
 
-func (r *userRepository) FindByUID(ctx context.Context, db string, uid string) (*domain.User, error) {
-	// 戻り値の例
-	var user *domain.User
-	if uid == "xxxx-xxxx-xxxx-0001" {
-		user = &domain.User{
-			ID:        1,
-			UID:       "xxxx-xxxx-xxxx-0001",
-			LastName:  "田中",
-			FirstName: "太郎",
-			Email:     "t.tanaka@example.com",
-			CreatedAt: time.Time{},
-			UpdatedAt: time.Time{},
-			DeletedAt: nil,
+func (r *userRepository) FindByUID(ctx context.Context, db repository.DB, uid string) (*domain.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE uid = $1 AND deleted_at IS NULL`
+
+	conn, err := database.AsConn(db)
+	if err != nil {
+		return nil, err
+	}
+	user, err := scanUser(conn.QueryRowContext(ctx, query, uid))
+	if err != nil {
+		// 対象ユーザーが存在しない場合はnilを返す
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
 		}
-	} else {
-		user = nil
+		return nil, r.logError(ctx, "ユーザーの取得に失敗しました。", err)
 	}
 
 	return user, nil
 }
 
-func (r *userRepository) Save(ctx context.Context, db string, user *domain.User) (*domain.User, error) {
-	// 戻り値の例
+func (r *userRepository) FindByEmail(ctx context.Context, db repository.DB, email string) (*domain.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE email = $1 AND deleted_at IS NULL`
+
+	conn, err := database.AsConn(db)
+	if err != nil {
+		return nil, err
+	}
+	user, err := scanUser(conn.QueryRowContext(ctx, query, email))
+	if err != nil {
+		// 対象ユーザーが存在しない場合はnilを返す
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, r.logError(ctx, "ユーザーの取得に失敗しました。", err)
+	}
+
 	return user, nil
 }
+
+func (r *userRepository) FindByUIDForUpdate(ctx context.Context, db repository.DB, uid string) (*domain.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE uid = $1 AND deleted_at IS NULL
+		FOR UPDATE`
+
+	conn, err := database.AsConn(db)
+	if err != nil {
+		return nil, err
+	}
+	user, err := scanUser(conn.QueryRowContext(ctx, query, uid))
+	if err != nil {
+		// 対象ユーザーが存在しない場合はnilを返す
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, r.logError(ctx, "ユーザーの取得に失敗しました。", err)
+	}
+
+	return user, nil
+}
+
+// 役割は更新しない
+func (r *userRepository) Save(ctx context.Context, db repository.DB, user *domain.User) (*domain.User, error) {
+	query := `
+		UPDATE users
+		SET last_name = $2, first_name = $3, email = $4, updated_at = $5, deleted_at = $6
+		WHERE id = $1
+		RETURNING ` + userColumns
+
+	conn, err := database.AsConn(db)
+	if err != nil {
+		return nil, err
+	}
+	row := conn.QueryRowContext(ctx, query, user.ID, user.LastName, user.FirstName, user.Email, user.UpdatedAt, user.DeletedAt)
+	saveUser, err := scanUser(row)
+	if err != nil {
+		if isEmailConflict(err) {
+			return nil, domain.NewErrEmailAlreadyExists()
+		}
+		return nil, r.logError(ctx, "ユーザーの更新に失敗しました。", err)
+	}
+
+	return saveUser, nil
+}
+
+// 並び替え項目とカラムの対応
+var sortColumns = map[domain.SortField]string{
+	domain.SortByCreatedAt: "created_at",
+	domain.SortByLastName:  "last_name",
+	domain.SortByEmail:     "email",
+}
+
+const (
+	// 一意制約違反のエラーコード
+	uniqueViolation = "23505"
+	// メールアドレスの一意制約（論理削除済みを除く）の名前
+	emailActiveUniqueIndex = "users_email_active_key"
+)
+
+// メールアドレスの一意制約違反かを判定する
+func isEmailConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == emailActiveUniqueIndex
+}
+
+// LIKE検索用に特殊文字をエスケープする
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+// エラーログを出力し、メッセージを付与したエラーを返す
+func (r *userRepository) logError(ctx context.Context, msg string, err error) error {
+	r.logger.Error(ctx, msg, "error", err)
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+// *sql.Rowと*sql.Rowsの共通インターフェース
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanUser(row rowScanner) (*domain.User, error) {
+	var user domain.User
+	var deletedAt sql.NullTime
+	err := row.Scan(
+		&user.ID,
+		&user.UID,
+		&user.LastName,
+		&user.FirstName,
+		&user.Email,
+		&user.Role,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// 未定義の役割は権限の判定を誤るためエラーとする
+	if !user.Role.Valid() {
+		return nil, fmt.Errorf("ユーザーの役割が不正です。: ID=%d, role=%s", user.ID, user.Role)
+	}
+
+	if deletedAt.Valid {
+		user.DeletedAt = &deletedAt.Time
+	}
+
+	return &user, nil
+}
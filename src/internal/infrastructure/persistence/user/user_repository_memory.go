@@ -0,0 +1,254 @@
+package user
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	logger_usecase "go-gin-domain/internal/application/usecase/logger"
+	"go-gin-domain/internal/domain/repository"
+	domain "go-gin-domain/internal/domain/user"
+	"go-gin-domain/internal/infrastructure/database"
+)
+
+type memoryUserRepository struct {
+	mu     sync.RWMutex
+	nextID int64
+	users  map[int64]*domain.User
+	// UIDからIDへの索引
+	uids map[string]int64
+	// 有効なユーザーのメールアドレスからIDへの索引
+	emails map[string]int64
+	logger logger_usecase.Logger
+}
+
+// インメモリのリポジトリ（ローカル開発およびテスト用）
+// トランザクション内の変更は、ロールバック時に元に戻す処理をトランザクションに登録する
+func NewMemoryUserRepository(logger logger_usecase.Logger) domain.UserRepository {
+	return &memoryUserRepository{
+		nextID: 1,
+		users:  map[int64]*domain.User{},
+		uids:   map[string]int64{},
+		emails: map[string]int64{},
+		logger: logger,
+	}
+}
+
+// インメモリのリポジトリはDB接続を利用しないため、dbはトランザクションの判定にのみ利用する
+func (r *memoryUserRepository) Create(ctx context.Context, db repository.DB, user *domain.User) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// UIDの重複チェック（DBの一意制約に相当）
+	if _, ok := r.uids[user.UID]; ok {
+		err := fmt.Errorf("ユーザーの登録に失敗しました。: UIDが重複しています。: UID=%s", user.UID)
+		r.logger.Error(ctx, "ユーザーの登録に失敗しました。", "uid", user.UID, "error", err)
+		return nil, err
+	}
+
+	// メールアドレスの重複チェック（DBの一意制約に相当）
+	if _, ok := r.emails[user.Email]; ok {
+		return nil, domain.NewErrEmailAlreadyExists()
+	}
+
+	now := time.Now()
+	createUser := copyUser(user)
+	createUser.ID = r.nextID
+	createUser.CreatedAt = now
+	createUser.UpdatedAt = now
+	createUser.DeletedAt = nil
+
+	r.users[createUser.ID] = createUser
+	r.uids[createUser.UID] = createUser.ID
+	r.emails[createUser.Email] = createUser.ID
+	r.nextID++
+
+	// ロールバック時は登録したユーザーのみ削除する（IDは再利用しない）
+	database.OnRollback(db, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.users, createUser.ID)
+		delete(r.uids, createUser.UID)
+		if id, ok := r.emails[createUser.Email]; ok && id == createUser.ID {
+			delete(r.emails, createUser.Email)
+		}
+	})
+
+	return copyUser(createUser), nil
+}
+
+func (r *memoryUserRepository) FindAll(ctx context.Context, db repository.DB, params domain.FindAllParams) (*domain.FindAllResult, error) {
+	params = params.Normalize()
+
+	// カーソルの値を前回の最後のユーザーとして比較に利用する
+	var after *domain.User
+	if params.Cursor != "" {
+		cursor, err := decodeCursor(params)
+		if err != nil {
+			return nil, err
+		}
+		after = &domain.User{ID: cursor.ID, CreatedAt: cursor.CreatedAt}
+		switch params.SortField {
+		case domain.SortByLastName:
+			after.LastName = cursor.Value
+		case domain.SortByEmail:
+			after.Email = cursor.Value
+		}
+	}
+
+	// 比較結果を並び順に合わせる
+	compare := func(a, b *domain.User) int {
+		c := compareUsers(a, b, params.SortField)
+		if params.SortOrder == domain.SortDesc {
+			return -c
+		}
+		return c
+	}
+
+	r.mu.RLock()
+	users := []*domain.User{}
+	for _, u := range r.users {
+		if !params.IncludeDeleted && u.DeletedAt != nil {
+			continue
+		}
+		if !matchUser(u, params) {
+			continue
+		}
+		if after != nil && compare(u, after) <= 0 {
+			continue
+		}
+		users = append(users, copyUser(u))
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(users, func(i, j int) bool {
+		return compare(users[i], users[j]) < 0
+	})
+
+	// 次のページの有無を判定するため1件多く残す
+	if len(users) > params.Limit+1 {
+		users = users[:params.Limit+1]
+	}
+
+	return newFindAllResult(users, params), nil
+}
+
+func (r *memoryUserRepository) FindByUID(ctx context.Context, db repository.DB, uid string) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if id, ok := r.uids[uid]; ok {
+		if u := r.users[id]; u.DeletedAt == nil {
+			return copyUser(u), nil
+		}
+	}
+
+	// 対象ユーザーが存在しない場合はnilを返す
+	return nil, nil
+}
+
+func (r *memoryUserRepository) FindByEmail(ctx context.Context, db repository.DB, email string) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if id, ok := r.emails[email]; ok {
+		return copyUser(r.users[id]), nil
+	}
+
+	// 対象ユーザーが存在しない場合はnilを返す
+	return nil, nil
+}
+
+// トランザクション同士はトランザクション管理で直列に実行されるため、ロックは不要
+func (r *memoryUserRepository) FindByUIDForUpdate(ctx context.Context, db repository.DB, uid string) (*domain.User, error) {
+	return r.FindByUID(ctx, db, uid)
+}
+
+func (r *memoryUserRepository) Save(ctx context.Context, db repository.DB, user *domain.User) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.users[user.ID]
+	if !ok {
+		err := fmt.Errorf("ユーザーの更新に失敗しました。: 対象ユーザーが存在しません。: UID=%s", user.UID)
+		r.logger.Error(ctx, "ユーザーの更新に失敗しました。", "uid", user.UID, "error", err)
+		return nil, err
+	}
+
+	// メールアドレスの重複チェック（DBの一意制約に相当）
+	if id, ok := r.emails[user.Email]; ok && id != user.ID && user.DeletedAt == nil {
+		return nil, domain.NewErrEmailAlreadyExists()
+	}
+
+	// UID、役割、作成日時は更新しない
+	saveUser := copyUser(user)
+	saveUser.UID = current.UID
+	saveUser.Role = current.Role
+	saveUser.CreatedAt = current.CreatedAt
+	r.replace(saveUser)
+
+	// ロールバック時は更新前の状態に戻す
+	database.OnRollback(db, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.replace(current)
+	})
+
+	return copyUser(saveUser), nil
+}
+
+// 同じIDのユーザーを置き換え、メールアドレスの索引を更新する（ロックを取得して呼び出す）
+func (r *memoryUserRepository) replace(user *domain.User) {
+	if current, ok := r.users[user.ID]; ok && current.DeletedAt == nil {
+		delete(r.emails, current.Email)
+	}
+	r.users[user.ID] = user
+	if user.DeletedAt == nil {
+		r.emails[user.Email] = user.ID
+	}
+}
+
+// 並び替え項目の値で比較し、同じ値の場合はIDで比較する
+func compareUsers(a, b *domain.User, field domain.SortField) int {
+	var c int
+	switch field {
+	case domain.SortByLastName:
+		c = strings.Compare(a.LastName, b.LastName)
+	case domain.SortByEmail:
+		c = strings.Compare(a.Email, b.Email)
+	default:
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if c == 0 {
+		c = cmp.Compare(a.ID, b.ID)
+	}
+	return c
+}
+
+// 検索条件に一致するか（大文字と小文字は区別しない）
+func matchUser(user *domain.User, params domain.FindAllParams) bool {
+	if params.EmailPrefix != "" && !strings.HasPrefix(strings.ToLower(user.Email), strings.ToLower(params.EmailPrefix)) {
+		return false
+	}
+	if params.NameContains != "" {
+		name := strings.ToLower(params.NameContains)
+		if !strings.Contains(strings.ToLower(user.LastName), name) && !strings.Contains(strings.ToLower(user.FirstName), name) {
+			return false
+		}
+	}
+	return true
+}
+
+// 呼び出し元との間でデータを共有しないようにコピーする
+func copyUser(user *domain.User) *domain.User {
+	u := *user
+	if user.DeletedAt != nil {
+		deletedAt := *user.DeletedAt
+		u.DeletedAt = &deletedAt
+	}
+	return &u
+}
@@ -0,0 +1,131 @@
+//go:build unit
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	mockLogger "go-gin-domain/internal/application/usecase/logger/mock_logger"
+	"go-gin-domain/internal/domain/repository"
+	domain "go-gin-domain/internal/domain/user"
+	"go-gin-domain/internal/infrastructure/database"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestUser(t *testing.T, uid, email string) *domain.User {
+	t.Helper()
+	user, err := domain.NewUser(uid, "田中", "太郎", email)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return user
+}
+
+func TestMemoryUserRepository_Rollback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	uid1 := "0196f1c2-7a3b-7c4d-8e5f-000000000011"
+	uid2 := "0196f1c2-7a3b-7c4d-8e5f-000000000012"
+	uid3 := "0196f1c2-7a3b-7c4d-8e5f-000000000013"
+
+	t.Run("ロールバック中のトランザクション外の登録が取り消されないこと", func(t *testing.T) {
+		repo := NewMemoryUserRepository(mockLogger.NewMockLogger(ctrl))
+		txManager := database.NewMemoryTxManager()
+
+		// 処理実行（トランザクションの実行中に、トランザクション外で別のユーザーを登録する）
+		err := txManager.Do(ctx, func(ctx context.Context, tx repository.DB) error {
+			if _, err := repo.Create(ctx, tx, newTestUser(t, uid1, "tx@example.com")); err != nil {
+				return err
+			}
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := repo.Create(ctx, nil, newTestUser(t, uid2, "non-tx@example.com"))
+				assert.NoError(t, err)
+			}()
+			wg.Wait()
+
+			return fmt.Errorf("Internal Server Error")
+		})
+
+		// 検証
+		assert.Error(t, err)
+
+		txUser, err := repo.FindByUID(ctx, nil, uid1)
+		assert.NoError(t, err)
+		assert.Nil(t, txUser)
+		txUser, err = repo.FindByEmail(ctx, nil, "tx@example.com")
+		assert.NoError(t, err)
+		assert.Nil(t, txUser)
+
+		nonTxUser, err := repo.FindByUID(ctx, nil, uid2)
+		assert.NoError(t, err)
+		assert.NotNil(t, nonTxUser)
+	})
+
+	t.Run("ロールバックしたユーザーのIDが再利用されないこと", func(t *testing.T) {
+		repo := NewMemoryUserRepository(mockLogger.NewMockLogger(ctrl))
+		txManager := database.NewMemoryTxManager()
+
+		// 処理実行
+		var rolledBackID int64
+		err := txManager.Do(ctx, func(ctx context.Context, tx repository.DB) error {
+			user, err := repo.Create(ctx, tx, newTestUser(t, uid1, "tx@example.com"))
+			if err != nil {
+				return err
+			}
+			rolledBackID = user.ID
+			return fmt.Errorf("Internal Server Error")
+		})
+		assert.Error(t, err)
+
+		user, err := repo.Create(ctx, nil, newTestUser(t, uid2, "tx@example.com"))
+
+		// 検証
+		assert.NoError(t, err)
+		assert.NotEqual(t, rolledBackID, user.ID)
+	})
+
+	t.Run("ロールバックした更新が元に戻ること", func(t *testing.T) {
+		repo := NewMemoryUserRepository(mockLogger.NewMockLogger(ctrl))
+		txManager := database.NewMemoryTxManager()
+		_, err := repo.Create(ctx, nil, newTestUser(t, uid3, "before@example.com"))
+		assert.NoError(t, err)
+
+		// 処理実行
+		err = txManager.Do(ctx, func(ctx context.Context, tx repository.DB) error {
+			user, err := repo.FindByUIDForUpdate(ctx, tx, uid3)
+			if err != nil {
+				return err
+			}
+			if err := user.UpdateProfile("佐藤", "花子", "after@example.com"); err != nil {
+				return err
+			}
+			if _, err := repo.Save(ctx, tx, user); err != nil {
+				return err
+			}
+			return fmt.Errorf("Internal Server Error")
+		})
+
+		// 検証
+		assert.Error(t, err)
+
+		user, err := repo.FindByUID(ctx, nil, uid3)
+		assert.NoError(t, err)
+		assert.Equal(t, "田中", user.LastName)
+		assert.Equal(t, "before@example.com", user.Email)
+
+		after, err := repo.FindByEmail(ctx, nil, "after@example.com")
+		assert.NoError(t, err)
+		assert.Nil(t, after)
+	})
+}
@@ -0,0 +1,51 @@
+//go:build unit
+
+package user
+
+import (
+	"testing"
+	"time"
+
+	domain "go-gin-domain/internal/domain/user"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// テスト用の行（scanUserの列の順に値を保持する）
+type testRow struct {
+	role domain.Role
+}
+
+func (r testRow) Scan(dest ...any) error {
+	*dest[0].(*int64) = 1
+	*dest[1].(*string) = "xxxx-xxxx-xxxx-0001"
+	*dest[2].(*string) = "田中"
+	*dest[3].(*string) = "太郎"
+	*dest[4].(*string) = "t.tanaka@example.com"
+	*dest[5].(*domain.Role) = r.role
+	*dest[6].(*time.Time) = time.Time{}
+	*dest[7].(*time.Time) = time.Time{}
+	return nil
+}
+
+func TestScanUser(t *testing.T) {
+	t.Run("定義済みの役割の場合は取得できること", func(t *testing.T) {
+		for _, role := range []domain.Role{domain.RoleAdmin, domain.RoleMember} {
+			// テストの実行
+			user, err := scanUser(testRow{role: role})
+
+			// 検証
+			assert.NoError(t, err)
+			assert.Equal(t, role, user.Role)
+		}
+	})
+
+	t.Run("未定義の役割の場合はエラーを返すこと", func(t *testing.T) {
+		// テストの実行
+		user, err := scanUser(testRow{role: "owner"})
+
+		// 検証
+		assert.Nil(t, user)
+		assert.EqualError(t, err, "ユーザーの役割が不正です。: ID=1, role=owner")
+	})
+}
@@ -0,0 +1,79 @@
+package user
+
+import (
+	"context"
+
+	"go-gin-domain/internal/application/usecase/tracing"
+	"go-gin-domain/internal/domain/repository"
+	domain "go-gin-domain/internal/domain/user"
+
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("go-gin-domain/internal/infrastructure/persistence/user")
+
+// トレースのスパンを記録するためのラッパー
+type tracingUserRepository struct {
+	next domain.UserRepository
+}
+
+// 各メソッドの呼び出しをスパンとして記録する
+func NewTracingUserRepository(next domain.UserRepository) domain.UserRepository {
+	return &tracingUserRepository{
+		next: next,
+	}
+}
+
+func (r *tracingUserRepository) Create(ctx context.Context, db repository.DB, user *domain.User) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "UserRepository.Create")
+	defer span.End()
+
+	result, err := r.next.Create(ctx, db, user)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (r *tracingUserRepository) FindAll(ctx context.Context, db repository.DB, params domain.FindAllParams) (*domain.FindAllResult, error) {
+	ctx, span := tracer.Start(ctx, "UserRepository.FindAll")
+	defer span.End()
+
+	result, err := r.next.FindAll(ctx, db, params)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (r *tracingUserRepository) FindByUID(ctx context.Context, db repository.DB, uid string) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "UserRepository.FindByUID")
+	defer span.End()
+
+	result, err := r.next.FindByUID(ctx, db, uid)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (r *tracingUserRepository) FindByEmail(ctx context.Context, db repository.DB, email string) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "UserRepository.FindByEmail")
+	defer span.End()
+
+	result, err := r.next.FindByEmail(ctx, db, email)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (r *tracingUserRepository) FindByUIDForUpdate(ctx context.Context, db repository.DB, uid string) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "UserRepository.FindByUIDForUpdate")
+	defer span.End()
+
+	result, err := r.next.FindByUIDForUpdate(ctx, db, uid)
+	tracing.RecordError(span, err)
+	return result, err
+}
+
+func (r *tracingUserRepository) Save(ctx context.Context, db repository.DB, user *domain.User) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "UserRepository.Save")
+	defer span.End()
+
+	result, err := r.next.Save(ctx, db, user)
+	tracing.RecordError(span, err)
+	return result, err
+}
@@ -0,0 +1,170 @@
+//go:build unit
+
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go-gin-domain/internal/domain/repository"
+	domain "go-gin-domain/internal/domain/user"
+	mockUser "go-gin-domain/internal/domain/user/mock_user_repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+)
+
+// 終了したスパンを記録する（パッケージのtracerは最初に設定したプロバイダーを使い続けるため、1度だけ設定する）
+var spanRecorder = func() *tracetest.SpanRecorder {
+	sr := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	return sr
+}()
+
+func TestTracingUserRepository(t *testing.T) {
+	// リポジトリのモック
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mockUser.NewMockUserRepository(ctrl)
+
+	repo := NewTracingUserRepository(mockRepo)
+
+	// 呼び出し元のスパン
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	defer parent.End()
+
+	// 次の処理に渡されたコンテキストのスパン
+	var nextSpan trace.SpanContext
+
+	user := newTestUser(t, "0196f1c2-7a3b-7c4d-8e5f-000000000001", "taro@example.com")
+
+	tests := []struct {
+		spanName string
+		// errを返すようにモック化して呼び出す
+		call func(err error) error
+	}{
+		{
+			spanName: "UserRepository.Create",
+			call: func(err error) error {
+				mockRepo.EXPECT().Create(gomock.Any(), nil, user).DoAndReturn(
+					func(ctx context.Context, _ repository.DB, _ *domain.User) (*domain.User, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = repo.Create(ctx, nil, user)
+				return err
+			},
+		},
+		{
+			spanName: "UserRepository.FindAll",
+			call: func(err error) error {
+				mockRepo.EXPECT().FindAll(gomock.Any(), nil, domain.FindAllParams{}).DoAndReturn(
+					func(ctx context.Context, _ repository.DB, _ domain.FindAllParams) (*domain.FindAllResult, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = repo.FindAll(ctx, nil, domain.FindAllParams{})
+				return err
+			},
+		},
+		{
+			spanName: "UserRepository.FindByUID",
+			call: func(err error) error {
+				mockRepo.EXPECT().FindByUID(gomock.Any(), nil, "0196f1c2-7a3b-7c4d-8e5f-000000000001").DoAndReturn(
+					func(ctx context.Context, _ repository.DB, _ string) (*domain.User, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = repo.FindByUID(ctx, nil, "0196f1c2-7a3b-7c4d-8e5f-000000000001")
+				return err
+			},
+		},
+		{
+			spanName: "UserRepository.FindByEmail",
+			call: func(err error) error {
+				mockRepo.EXPECT().FindByEmail(gomock.Any(), nil, "taro@example.com").DoAndReturn(
+					func(ctx context.Context, _ repository.DB, _ string) (*domain.User, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = repo.FindByEmail(ctx, nil, "taro@example.com")
+				return err
+			},
+		},
+		{
+			spanName: "UserRepository.FindByUIDForUpdate",
+			call: func(err error) error {
+				mockRepo.EXPECT().FindByUIDForUpdate(gomock.Any(), nil, "0196f1c2-7a3b-7c4d-8e5f-000000000001").DoAndReturn(
+					func(ctx context.Context, _ repository.DB, _ string) (*domain.User, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = repo.FindByUIDForUpdate(ctx, nil, "0196f1c2-7a3b-7c4d-8e5f-000000000001")
+				return err
+			},
+		},
+		{
+			spanName: "UserRepository.Save",
+			call: func(err error) error {
+				mockRepo.EXPECT().Save(gomock.Any(), nil, user).DoAndReturn(
+					func(ctx context.Context, _ repository.DB, _ *domain.User) (*domain.User, error) {
+						nextSpan = trace.SpanContextFromContext(ctx)
+						return nil, err
+					},
+				)
+				_, err = repo.Save(ctx, nil, user)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spanName+"のスパンを呼び出し元の子として記録すること", func(t *testing.T) {
+			spanRecorder.Reset()
+
+			// テストの実行
+			err := tt.call(nil)
+
+			// 検証
+			assert.NoError(t, err)
+			spans := spanRecorder.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			assert.Equal(t, tt.spanName, span.Name())
+			assert.Equal(t, parent.SpanContext().TraceID(), span.SpanContext().TraceID())
+			assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+			assert.Equal(t, span.SpanContext().SpanID(), nextSpan.SpanID())
+			assert.Equal(t, codes.Unset, span.Status().Code)
+		})
+
+		t.Run(tt.spanName+"でエラーの場合にスパンにエラーを記録すること", func(t *testing.T) {
+			spanRecorder.Reset()
+
+			// テストの実行
+			err := tt.call(errors.New("Internal Server Error"))
+
+			// 検証
+			assert.Error(t, err)
+			spans := spanRecorder.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			assert.Equal(t, tt.spanName, span.Name())
+			assert.Equal(t, codes.Error, span.Status().Code)
+			assert.Equal(t, "Internal Server Error", span.Status().Description)
+			require.Len(t, span.Events(), 1)
+			assert.Equal(t, "exception", span.Events()[0].Name)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+)
+
+// スパンの出力先
+const (
+	// 出力しない（トレースIDの付与とW3C Trace Contextの伝播のみ行う）
+	ExporterNone = "none"
+	// 標準出力（ローカル開発用）
+	ExporterStdout = "stdout"
+	// OTLP/HTTPでコレクターに送信する
+	ExporterOTLP = "otlp"
+)
+
+// トレースの設定
+type Config struct {
+	ServiceName string
+	Exporter    string
+	// OTLPの送信先（例：http://localhost:4318）。空の場合はエクスポーターのデフォルト値。
+	Endpoint string
+	// サンプリングする割合（0〜1）。親スパンがある場合は親の判定に従う。
+	SampleRatio float64
+}
+
+// TracerProviderとW3C Trace Contextのプロパゲーターをグローバルに設定する
+// 終了時に戻り値の関数を呼び出し、未送信のスパンを送信する
+func NewTracerProvider(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	// W3C Trace Context（traceparent）とBaggageを伝播する
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.ServiceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("トレースのリソースの作成に失敗しました。: %w", err)
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	}
+
+	switch cfg.Exporter {
+	case ExporterNone:
+	case ExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("トレースのエクスポーターの作成に失敗しました。: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	case ExporterOTLP:
+		var exporterOpts []otlptracehttp.Option
+		if cfg.Endpoint != "" {
+			exporterOpts = append(exporterOpts, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+		}
+		exporter, err := otlptracehttp.New(ctx, exporterOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("トレースのエクスポーターの作成に失敗しました。: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	default:
+		return nil, fmt.Errorf("トレースのエクスポーターの種類が不正です。: %s", cfg.Exporter)
+	}
+
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
@@ -0,0 +1,16 @@
+package contextkey
+
+// 共通コンテキストに設定する値のキー
+// （ミドルウェア、ハンドラー、ロガーなどから参照するため独立したパッケージにする）
+type contextKey string
+
+const (
+	RequestId      contextKey = "Request-Id"
+	XRequestSource contextKey = "X-Request-Source"
+	UID            contextKey = "UID"
+	// trueの場合はログのサンプリングの対象外とする（リクエストごとに必ず出力するアクセスログなど）
+	SkipSampling contextKey = "Skip-Sampling"
+)
+
+// リクエストIDを受け渡すヘッダー名（レスポンスや外部APIへのリクエストにも付与する）
+const HeaderRequestId = "X-Request-Id"
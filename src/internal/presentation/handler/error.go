@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+
+	"go-gin-domain/internal/domain/apperror"
+	"go-gin-domain/internal/presentation/i18n"
+	"go-gin-domain/internal/presentation/problem"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// エラーの種類に応じたステータスでエラーを返す（全ハンドラー共通）
+func WriteError(c *gin.Context, err error) {
+	// メッセージはリクエストの言語に翻訳する
+	l := i18n.FromRequest(c.Request)
+
+	var errBadRequest *apperror.ErrBadRequest
+	var errValidation *apperror.ErrValidation
+	var errNotFound *apperror.ErrNotFound
+	var errConflict *apperror.ErrConflict
+	var errForbidden *apperror.ErrForbidden
+
+	switch {
+	case errors.As(err, &errBadRequest):
+		problem.Write(c, problem.New(l, problem.TypeBadRequest, l.Error(errBadRequest.Err)))
+	case errors.As(err, &errValidation):
+		fieldErrors := make([]problem.FieldError, 0, len(errValidation.Errors))
+		for _, e := range errValidation.Errors {
+			fieldErrors = append(fieldErrors, problem.FieldError{Field: e.Field, Message: l.Error(e.Err)})
+		}
+		problem.Write(c, problem.NewValidation(l, fieldErrors))
+	case errors.As(err, &errNotFound):
+		problem.Write(c, problem.New(l, problem.TypeNotFound, l.Error(errNotFound.Err)))
+	case errors.As(err, &errConflict):
+		problem.Write(c, problem.New(l, problem.TypeConflict, l.Error(errConflict.Err)))
+	case errors.As(err, &errForbidden):
+		problem.Write(c, problem.New(l, problem.TypeForbidden, l.Error(errForbidden.Err)))
+	default:
+		// 内部エラーの詳細はクライアントに返さず、アクセスログに出力する
+		_ = c.Error(err)
+		problem.Write(c, problem.New(l, problem.TypeInternal, ""))
+	}
+}
+
+// リクエストのバインドに失敗した場合にステータス422を返す
+func WriteBindError(c *gin.Context, err error) {
+	// メッセージはリクエストの言語に翻訳する
+	l := i18n.FromRequest(c.Request)
+
+	// バリデーションエラーの場合は項目ごとのエラーを返す
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		trans := translator(l)
+		fieldErrors := make([]problem.FieldError, 0, len(validationErrors))
+		for _, fe := range validationErrors {
+			fieldErrors = append(fieldErrors, problem.FieldError{Field: fe.Field(), Message: fe.Translate(trans)})
+		}
+		problem.Write(c, problem.NewValidation(l, fieldErrors))
+		return
+	}
+
+	// 型が一致しない場合
+	var errUnmarshalType *json.UnmarshalTypeError
+	if errors.As(err, &errUnmarshalType) && errUnmarshalType.Field != "" {
+		fieldErrors := []problem.FieldError{{Field: errUnmarshalType.Field, Message: l.Sprintf("値の型が不正です。")}}
+		problem.Write(c, problem.NewValidation(l, fieldErrors))
+		return
+	}
+
+	// JSONの構文エラーなど（元のエラーの内容は返さない）
+	_ = c.Error(err)
+	problem.Write(c, problem.New(l, problem.TypeValidation, l.Sprintf("リクエストの形式が不正です。")))
+}
@@ -0,0 +1,161 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go-gin-domain/internal/application/usecase/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// チェック処理のタイムアウトが未設定の場合のデフォルト値
+const DefaultCheckTimeout = 2 * time.Second
+
+// レディネスの判定で確認する依存先（DB、キャッシュ、メッセージブローカーなど）
+type Check struct {
+	Name string
+	// 1回のチェックのタイムアウト（0の場合はデフォルト値）
+	Timeout time.Duration
+	Check   func(ctx context.Context) error
+}
+
+type HealthHandler interface {
+	Liveness(c *gin.Context)
+	Readiness(c *gin.Context)
+	// シャットダウンの開始時に呼び出し、以降のレディネスの判定を失敗させる
+	SetShuttingDown()
+}
+
+type healthHandler struct {
+	checks       []Check
+	shuttingDown atomic.Bool
+	logger       logger.Logger
+}
+
+func NewHealthHandler(logger logger.Logger, checks ...Check) HealthHandler {
+	return &healthHandler{
+		checks: checks,
+		logger: logger,
+	}
+}
+
+// ステータス
+const (
+	StatusOK           = "ok"
+	StatusError        = "error"
+	StatusShuttingDown = "shutting_down"
+)
+
+// チェックが失敗した理由（認証なしで公開するため、依存先のエラーの詳細はログにのみ出力する）
+const (
+	CheckErrorTimeout     = "timeout"
+	CheckErrorUnavailable = "unavailable"
+)
+
+type LivenessResponse struct {
+	Status string `json:"status"`
+}
+
+type ReadinessResponse struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+type CheckResult struct {
+	Status string `json:"status"`
+	// チェックにかかった時間（ミリ秒）
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+}
+
+// プロセスが応答可能かどうか（依存先の状態は確認しない）
+func (h *healthHandler) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, LivenessResponse{Status: StatusOK})
+}
+
+// リクエストを受け付け可能かどうか（依存先を並行して確認し、一つでも失敗した場合はステータス503）
+func (h *healthHandler) Readiness(c *gin.Context) {
+	res := ReadinessResponse{
+		Status: StatusOK,
+		Checks: make(map[string]CheckResult, len(h.checks)),
+	}
+
+	// シャットダウン中はロードバランサーの振り分け対象から外す
+	if h.shuttingDown.Load() {
+		res.Status = StatusShuttingDown
+		c.JSON(http.StatusServiceUnavailable, res)
+		return
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, check := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := runCheck(c.Request.Context(), check)
+			if err != nil {
+				h.logger.Warn(c.Request.Context(), "依存先のチェックに失敗しました。", "check", check.Name, "error", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			res.Checks[check.Name] = result
+			if result.Status != StatusOK {
+				res.Status = StatusError
+			}
+		}()
+	}
+	wg.Wait()
+
+	status := http.StatusOK
+	if res.Status != StatusOK {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, res)
+}
+
+func (h *healthHandler) SetShuttingDown() {
+	h.shuttingDown.Store(true)
+}
+
+// タイムアウトを設定してチェックを実行する（失敗した場合は元のエラーも返す）
+func runCheck(ctx context.Context, check Check) (CheckResult, error) {
+	timeout := check.Timeout
+	if timeout <= 0 {
+		timeout = DefaultCheckTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// コンテキストを参照しないチェックでもタイムアウトで打ち切る
+	start := time.Now()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- check.Check(ctx)
+	}()
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	result := CheckResult{
+		Status:     StatusOK,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Status = StatusError
+		result.Error = CheckErrorUnavailable
+		if errors.Is(err, context.DeadlineExceeded) {
+			result.Error = CheckErrorTimeout
+		}
+	}
+
+	return result, err
+}
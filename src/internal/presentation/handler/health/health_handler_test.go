@@ -0,0 +1,137 @@
+//go:build unit
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockLogger "go-gin-domain/internal/application/usecase/logger/mock_logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// テスト用Ginの初期化処理
+func initTestGin(h HealthHandler) *gin.Engine {
+	r := gin.New()
+	r.GET("/healthz", h.Liveness)
+	r.GET("/readyz", h.Readiness)
+
+	return r
+}
+
+func TestHealthHandler_Liveness(t *testing.T) {
+	// Ginのテストモードに設定
+	gin.SetMode(gin.TestMode)
+
+	// ロガーのモック
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := mockLogger.NewMockLogger(ctrl)
+
+	t.Run("ステータス200で返すこと", func(t *testing.T) {
+		// 依存先の状態には影響されない
+		h := NewHealthHandler(mockLogger, Check{Name: "database", Check: func(ctx context.Context) error {
+			return errors.New("connection refused")
+		}})
+		r := initTestGin(h)
+
+		req := httptest.NewRequest("GET", "/healthz", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"status":"ok"}`, w.Body.String())
+	})
+}
+
+func TestHealthHandler_Readiness(t *testing.T) {
+	// Ginのテストモードに設定
+	gin.SetMode(gin.TestMode)
+
+	// ロガーのモック
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := mockLogger.NewMockLogger(ctrl)
+
+	okCheck := Check{Name: "database", Check: func(ctx context.Context) error { return nil }}
+
+	t.Run("全てのチェックが成功した場合はステータス200で返すこと", func(t *testing.T) {
+		h := NewHealthHandler(mockLogger, okCheck, Check{Name: "cache", Check: func(ctx context.Context) error { return nil }})
+		r := initTestGin(h)
+
+		req := httptest.NewRequest("GET", "/readyz", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var res ReadinessResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.Equal(t, StatusOK, res.Status)
+		assert.Equal(t, StatusOK, res.Checks["database"].Status)
+		assert.Equal(t, StatusOK, res.Checks["cache"].Status)
+	})
+
+	t.Run("チェックが失敗した場合はステータス503で返し、エラーの詳細はログにのみ出力すること", func(t *testing.T) {
+		mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), "check", "cache", "error", errors.New("dial tcp 10.0.0.1:6379: connection refused")).Return()
+		h := NewHealthHandler(mockLogger, okCheck, Check{Name: "cache", Check: func(ctx context.Context) error {
+			return errors.New("dial tcp 10.0.0.1:6379: connection refused")
+		}})
+		r := initTestGin(h)
+
+		req := httptest.NewRequest("GET", "/readyz", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		var res ReadinessResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.Equal(t, StatusError, res.Status)
+		assert.Equal(t, StatusOK, res.Checks["database"].Status)
+		assert.Equal(t, StatusError, res.Checks["cache"].Status)
+		assert.Equal(t, CheckErrorUnavailable, res.Checks["cache"].Error)
+		assert.NotContains(t, w.Body.String(), "10.0.0.1")
+	})
+
+	t.Run("タイムアウトした場合はステータス503で返すこと", func(t *testing.T) {
+		mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), "check", "broker", "error", context.DeadlineExceeded).Return()
+		// コンテキストを参照せずに応答しないチェック
+		block := make(chan struct{})
+		defer close(block)
+		h := NewHealthHandler(mockLogger, Check{Name: "broker", Timeout: 10 * time.Millisecond, Check: func(ctx context.Context) error {
+			<-block
+			return nil
+		}})
+		r := initTestGin(h)
+
+		req := httptest.NewRequest("GET", "/readyz", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		var res ReadinessResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.Equal(t, StatusError, res.Checks["broker"].Status)
+		assert.Equal(t, CheckErrorTimeout, res.Checks["broker"].Error)
+	})
+
+	t.Run("シャットダウン中はステータス503で返すこと", func(t *testing.T) {
+		h := NewHealthHandler(mockLogger, okCheck)
+		h.SetShuttingDown()
+		r := initTestGin(h)
+
+		req := httptest.NewRequest("GET", "/readyz", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.JSONEq(t, `{"status":"shutting_down","checks":{}}`, w.Body.String())
+	})
+}
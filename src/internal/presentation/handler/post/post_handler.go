@@ -1,19 +1,33 @@
 package post
 
 import (
-	"errors"
-	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
+	"go-gin-domain/internal/application/usecase/authorization"
 	usecase "go-gin-domain/internal/application/usecase/post"
+	"go-gin-domain/internal/domain/apperror"
 	domain "go-gin-domain/internal/domain/post"
+	"go-gin-domain/internal/presentation/contextkey"
+	"go-gin-domain/internal/presentation/handler"
 
 	"github.com/gin-gonic/gin"
 )
 
+// カスタムエラー用の構造体を定義
+type ErrInvalidID struct{}
+
+func (e *ErrInvalidID) Error() string {
+	return "IDは1以上の整数を指定して下さい。"
+}
+
 type PostHandler interface {
 	Create(c *gin.Context)
 	FindAll(c *gin.Context)
+	FindByID(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
 }
 
 type postHandler struct {
@@ -32,6 +46,26 @@ type CreatePostRequestBody struct {
 	Text string `json:"text" binding:"required"`
 }
 
+type UpdatePostRequestBody struct {
+	Text string `json:"text" binding:"required"`
+}
+
+type FindAllPostQuery struct {
+	// 0を未指定と区別するためポインタにする
+	Limit         *int      `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor        string    `form:"cursor"`
+	AuthorUID     string    `form:"author_uid"`
+	CreatedAfter  time.Time `form:"created_after" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedBefore time.Time `form:"created_before" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+type FindAllPostResponse struct {
+	Posts []*domain.PostResponse `json:"posts"`
+	// 次のページが無い場合はnull
+	NextCursor *string `json:"next_cursor"`
+	HasMore    bool    `json:"has_more"`
+}
+
 func (h *postHandler) Create(c *gin.Context) {
 	// 共通コンテキスト
 	ctx := c.Request.Context()
@@ -39,32 +73,17 @@ func (h *postHandler) Create(c *gin.Context) {
 	// バリデーションチェック
 	var reqBody CreatePostRequestBody
 	if err := c.ShouldBindJSON(&reqBody); err != nil {
-		msg := fmt.Sprintf("バリデーションエラー: %s", err.Error())
-		c.JSON(http.StatusUnprocessableEntity, gin.H{
-			"message": msg,
-		})
+		handler.WriteBindError(c, err)
 		return
 	}
 
-	post, err := h.postUsecase.Create(ctx, reqBody.Text)
+	// 認証済みのUID（Authミドルウェアで設定）
+	uid, _ := ctx.Value(contextkey.UID).(string)
+
+	post, err := h.postUsecase.Create(ctx, uid, reqBody.Text)
 	if err != nil {
-		// カスタムエラー判定（バリデーションエラーかを判定）
-		var ErrInvalidLength *domain.ErrInvalidLength
-		if errors.As(err, &ErrInvalidLength) {
-			// バリデーションエラーの場合
-			msg := fmt.Sprintf("Unprocessable Entity: %s", err.Error())
-			c.JSON(http.StatusUnprocessableEntity, gin.H{
-				"message": msg,
-			})
-			return
-		} else {
-			// サーバーエラーの場合
-			msg := fmt.Sprintf("Internal Server Error: %s", err.Error())
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"message": msg,
-			})
-			return
-		}
+		handler.WriteError(c, err)
+		return
 	}
 
 	// postをDTO用の関数で変換して返す
@@ -75,22 +94,124 @@ func (h *postHandler) FindAll(c *gin.Context) {
 	// 共通コンテキスト
 	ctx := c.Request.Context()
 
-	posts, err := h.postUsecase.FindAll(ctx)
+	// バリデーションチェック
+	var query FindAllPostQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		handler.WriteBindError(c, err)
+		return
+	}
+
+	params := domain.FindAllParams{
+		Cursor:        query.Cursor,
+		AuthorUID:     query.AuthorUID,
+		CreatedAfter:  query.CreatedAfter,
+		CreatedBefore: query.CreatedBefore,
+	}
+	if query.Limit != nil {
+		params.Limit = *query.Limit
+	}
+
+	result, err := h.postUsecase.FindAll(ctx, params)
 	if err != nil {
-		msg := fmt.Sprintf("Internal Server Error: %s", err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"message": msg,
-		})
+		handler.WriteError(c, err)
 		return
 	}
 
 	// レスポンス用のスライスを定義
-	resPosts := make([]*domain.PostResponse, 0, len(posts))
+	resPosts := make([]*domain.PostResponse, 0, len(result.Posts))
 
 	// ループ処理でpostをDTO用の関数で変換し、レスポンス用のスライスに追加
-	for _, post := range posts {
+	for _, post := range result.Posts {
 		resPosts = append(resPosts, domain.ToResponse(post))
 	}
 
-	c.JSON(http.StatusOK, resPosts)
+	res := FindAllPostResponse{
+		Posts:   resPosts,
+		HasMore: result.HasMore,
+	}
+	if result.NextCursor != "" {
+		res.NextCursor = &result.NextCursor
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *postHandler) FindByID(c *gin.Context) {
+	// 共通コンテキスト
+	ctx := c.Request.Context()
+
+	// バリデーションチェック
+	id, ok := bindID(c)
+	if !ok {
+		return
+	}
+
+	post, err := h.postUsecase.FindByID(ctx, id)
+	if err != nil {
+		handler.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.ToResponse(post))
+}
+
+func (h *postHandler) Update(c *gin.Context) {
+	// 共通コンテキスト
+	ctx := c.Request.Context()
+
+	// バリデーションチェック
+	id, ok := bindID(c)
+	if !ok {
+		return
+	}
+
+	var reqBody UpdatePostRequestBody
+	if err := c.ShouldBindJSON(&reqBody); err != nil {
+		handler.WriteBindError(c, err)
+		return
+	}
+
+	// 操作するユーザー（認可用のミドルウェアで設定）
+	actor, _ := authorization.ActorFromContext(ctx)
+
+	post, err := h.postUsecase.Update(ctx, actor, id, reqBody.Text)
+	if err != nil {
+		handler.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.ToResponse(post))
+}
+
+func (h *postHandler) Delete(c *gin.Context) {
+	// 共通コンテキスト
+	ctx := c.Request.Context()
+
+	// バリデーションチェック
+	id, ok := bindID(c)
+	if !ok {
+		return
+	}
+
+	// 操作するユーザー（認可用のミドルウェアで設定）
+	actor, _ := authorization.ActorFromContext(ctx)
+
+	post, err := h.postUsecase.Delete(ctx, actor, id)
+	if err != nil {
+		handler.WriteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.ToResponse(post))
+}
+
+// パスパラメータのidを取得し、不正な場合はステータス400を返す
+func bindID(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		handler.WriteError(c, &apperror.ErrBadRequest{Err: &ErrInvalidID{}})
+		return 0, false
+	}
+
+	return id, true
 }
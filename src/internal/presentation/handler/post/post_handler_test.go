@@ -0,0 +1,608 @@
+//go:build unit
+
+package post
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"go-gin-domain/internal/application/usecase/authorization"
+	usecase "go-gin-domain/internal/application/usecase/post"
+	mockPost "go-gin-domain/internal/application/usecase/post/mock_post"
+	"go-gin-domain/internal/domain/apperror"
+	domain_post "go-gin-domain/internal/domain/post"
+	"go-gin-domain/internal/domain/repository"
+	domain_user "go-gin-domain/internal/domain/user"
+	"go-gin-domain/internal/infrastructure/logger"
+	"go-gin-domain/internal/presentation/middleware"
+	"go-gin-domain/internal/presentation/problem"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 初期処理
+func init() {
+	// テスト用の環境変数ファイル「.env.testing」を読み込んで使用する。
+	if err := godotenv.Load("../../../../.env.testing"); err != nil {
+		fmt.Println(".env.testingの読み込みに失敗しました。")
+	}
+}
+
+// アクセスログ用のロガー
+var testLogger = logger.NewSlogLogger(logger.NewSlogHandler(logger.Config{}))
+
+// テスト用Ginの初期化処理
+func initTestGin() (*gin.Engine, *gin.RouterGroup) {
+	r := gin.New()
+
+	// ミドルウェアの設定
+	m := middleware.NewMiddleware(nil, nil, nil, testLogger, middleware.AccessLogConfig{}, false)
+	r.Use(m.Request())
+	r.Use(m.AccessLog())
+	r.Use(gin.Recovery())
+
+	apiV1 := r.Group("/api/v1")
+
+	return r, apiV1
+}
+
+func TestPostHandler_FindAll(t *testing.T) {
+	// Ginのテストモードに設定
+	gin.SetMode(gin.TestMode)
+
+	// ユースケースのモック
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockPostUsecase := mockPost.NewMockPostUsecase(ctrl)
+
+	createdAfter := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	createdBefore := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("ステータス200で正常終了すること", func(t *testing.T) {
+		// モック化
+		expectedPosts := []*domain_post.Post{
+			domain_post.ReconstitutePost(1, "こんにちは", "xxxx-xxxx-xxxx-0001", createdAfter, createdAfter, nil),
+			domain_post.ReconstitutePost(2, "こんばんは", "xxxx-xxxx-xxxx-0001", createdAfter, createdAfter, nil),
+		}
+		expectedParams := domain_post.FindAllParams{
+			Limit:         2,
+			Cursor:        "xxxxxx",
+			AuthorUID:     "xxxx-xxxx-xxxx-0001",
+			CreatedAfter:  createdAfter,
+			CreatedBefore: createdBefore,
+		}
+		mockPostUsecase.EXPECT().FindAll(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, params domain_post.FindAllParams) (*domain_post.FindAllResult, error) {
+				assert.Equal(t, expectedParams.Limit, params.Limit)
+				assert.Equal(t, expectedParams.Cursor, params.Cursor)
+				assert.Equal(t, expectedParams.AuthorUID, params.AuthorUID)
+				assert.True(t, expectedParams.CreatedAfter.Equal(params.CreatedAfter))
+				assert.True(t, expectedParams.CreatedBefore.Equal(params.CreatedBefore))
+				return &domain_post.FindAllResult{
+					Posts:      expectedPosts,
+					NextCursor: "yyyyyy",
+					HasMore:    true,
+				}, nil
+			})
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.GET("/posts", h.FindAll)
+
+		// リクエスト設定
+		query := url.Values{}
+		query.Set("limit", "2")
+		query.Set("cursor", "xxxxxx")
+		query.Set("author_uid", "xxxx-xxxx-xxxx-0001")
+		query.Set("created_after", createdAfter.Format(time.RFC3339))
+		query.Set("created_before", createdBefore.Format(time.RFC3339))
+		path := "/api/v1/posts?" + query.Encode()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var res struct {
+			Posts      []map[string]interface{} `json:"posts"`
+			NextCursor *string                  `json:"next_cursor"`
+			HasMore    bool                     `json:"has_more"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, "yyyyyy", *res.NextCursor)
+		assert.True(t, res.HasMore)
+
+		list := res.Posts
+		assert.Equal(t, len(expectedPosts), len(list))
+		for i, expectedPost := range expectedPosts {
+			assert.Equal(t, float64(expectedPost.ID()), list[i]["id"])
+			assert.Equal(t, expectedPost.TextValue(), list[i]["text"])
+			assert.Equal(t, expectedPost.AuthorUID(), list[i]["author_uid"])
+			assert.NotNil(t, list[i]["created_at"])
+			assert.NotNil(t, list[i]["updated_at"])
+			assert.Nil(t, list[i]["deleted_at"])
+		}
+	})
+
+	t.Run("次のページが無い場合にnext_cursorがnullになること", func(t *testing.T) {
+		// モック化
+		mockPostUsecase.EXPECT().FindAll(gomock.Any(), domain_post.FindAllParams{}).Return(&domain_post.FindAllResult{
+			Posts: []*domain_post.Post{},
+		}, nil)
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.GET("/posts", h.FindAll)
+
+		// リクエスト設定
+		path := "/api/v1/posts"
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"posts":[],"next_cursor":null,"has_more":false}`, w.Body.String())
+	})
+
+	t.Run("ユースケースでエラーが発生した場合にステータス500を返すこと", func(t *testing.T) {
+		// モック化
+		err := fmt.Errorf("Internal Server Error")
+		mockPostUsecase.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, err)
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.GET("/posts", h.FindAll)
+
+		// リクエスト設定
+		path := "/api/v1/posts"
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeInternal)
+	})
+
+	t.Run("クエリパラメータが不正な場合にステータス422を返すこと", func(t *testing.T) {
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.GET("/posts", h.FindAll)
+
+		for _, query := range []string{"limit=0", "limit=101", "created_after=2025-01-01", "created_before=xxx"} {
+			// リクエスト設定
+			path := "/api/v1/posts?" + query
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+
+			// テストの実行
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// 検証
+			assert.Equal(t, http.StatusUnprocessableEntity, w.Code, query)
+			assert.Contains(t, w.Body.String(), problem.TypeValidation)
+		}
+	})
+
+	t.Run("created_afterがcreated_beforeより後の場合にステータス422を返すこと", func(t *testing.T) {
+		// モック化
+		mockPostUsecase.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, apperror.NewErrValidation("created_before", &domain_post.ErrInvalidPeriod{}))
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.GET("/posts", h.FindAll)
+
+		// リクエスト設定
+		query := url.Values{}
+		query.Set("created_after", createdBefore.Format(time.RFC3339))
+		query.Set("created_before", createdAfter.Format(time.RFC3339))
+		path := "/api/v1/posts?" + query.Encode()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeValidation)
+		assert.Contains(t, w.Body.String(), "created_before")
+	})
+
+	t.Run("カーソルが不正な場合にステータス400を返すこと", func(t *testing.T) {
+		// モック化
+		mockPostUsecase.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, &apperror.ErrBadRequest{Err: &repository.ErrInvalidCursor{}})
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.GET("/posts", h.FindAll)
+
+		// リクエスト設定
+		path := "/api/v1/posts?cursor=xxxxxx"
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeBadRequest)
+		assert.Contains(t, w.Body.String(), "カーソルの値が不正です。")
+	})
+}
+
+func TestPostHandler_FindByID(t *testing.T) {
+	// Ginのテストモードに設定
+	gin.SetMode(gin.TestMode)
+
+	// ユースケースのモック
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockPostUsecase := mockPost.NewMockPostUsecase(ctrl)
+
+	t.Run("ステータス200で正常終了すること", func(t *testing.T) {
+		// モック化
+		expectedPost := domain_post.ReconstitutePost(1, "こんにちは", "xxxx-xxxx-xxxx-0001", time.Time{}, time.Time{}, nil)
+		mockPostUsecase.EXPECT().FindByID(gomock.Any(), int64(1)).Return(expectedPost, nil)
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.GET("/post/:id", h.FindByID)
+
+		// リクエスト設定
+		path := "/api/v1/post/1"
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var data map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, float64(1), data["id"])
+		assert.Equal(t, "こんにちは", data["text"])
+		assert.Equal(t, "xxxx-xxxx-xxxx-0001", data["author_uid"])
+		assert.Nil(t, data["deleted_at"])
+	})
+
+	t.Run("対象のPostが存在しないまたは論理削除済みの場合にステータス404を返すこと", func(t *testing.T) {
+		// モック化
+		mockPostUsecase.EXPECT().FindByID(gomock.Any(), int64(1)).Return(nil, &apperror.ErrNotFound{Err: &usecase.ErrPostNotFound{}})
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.GET("/post/:id", h.FindByID)
+
+		// リクエスト設定
+		path := "/api/v1/post/1"
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeNotFound)
+	})
+
+	t.Run("ユースケースでエラーが発生した場合にステータス500を返すこと", func(t *testing.T) {
+		// モック化
+		mockPostUsecase.EXPECT().FindByID(gomock.Any(), int64(1)).Return(nil, fmt.Errorf("Internal Server Error"))
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.GET("/post/:id", h.FindByID)
+
+		// リクエスト設定
+		path := "/api/v1/post/1"
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeInternal)
+	})
+
+	t.Run("idが不正な場合にステータス400を返すこと", func(t *testing.T) {
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.GET("/post/:id", h.FindByID)
+
+		for _, id := range []string{"abc", "0", "-1", "1.5"} {
+			// リクエスト設定
+			path := "/api/v1/post/" + id
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+
+			// テストの実行
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// 検証
+			assert.Equal(t, http.StatusBadRequest, w.Code, id)
+			assert.Contains(t, w.Body.String(), problem.TypeBadRequest)
+		}
+	})
+}
+
+func TestPostHandler_Update(t *testing.T) {
+	// Ginのテストモードに設定
+	gin.SetMode(gin.TestMode)
+
+	// ユースケースのモック
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockPostUsecase := mockPost.NewMockPostUsecase(ctrl)
+
+	// 操作するユーザー（認可用のミドルウェアで設定される値）
+	actor := authorization.Actor{UID: "xxxx-xxxx-xxxx-0001", Role: domain_user.RoleMember}
+	setActor := func(c *gin.Context) {
+		c.Request = c.Request.WithContext(authorization.WithActor(c.Request.Context(), actor))
+		c.Next()
+	}
+
+	// リクエストボディ
+	jsonReqBody, err := json.Marshal(UpdatePostRequestBody{Text: "こんばんは"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("ステータス200で正常終了すること", func(t *testing.T) {
+		// モック化
+		expectedPost := domain_post.ReconstitutePost(1, "こんばんは", "xxxx-xxxx-xxxx-0001", time.Time{}, time.Now(), nil)
+		mockPostUsecase.EXPECT().Update(gomock.Any(), actor, int64(1), "こんばんは").Return(expectedPost, nil)
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.PUT("/post/:id", setActor, h.Update)
+
+		// リクエスト設定
+		path := "/api/v1/post/1"
+		req := httptest.NewRequest(http.MethodPut, path, bytes.NewBuffer(jsonReqBody))
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var data map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, float64(1), data["id"])
+		assert.Equal(t, "こんばんは", data["text"])
+	})
+
+	t.Run("対象のPostが存在しないまたは論理削除済みの場合にステータス404を返すこと", func(t *testing.T) {
+		// モック化
+		mockPostUsecase.EXPECT().Update(gomock.Any(), actor, int64(1), gomock.Any()).Return(nil, &apperror.ErrNotFound{Err: &usecase.ErrPostNotFound{}})
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.PUT("/post/:id", setActor, h.Update)
+
+		// リクエスト設定
+		path := "/api/v1/post/1"
+		req := httptest.NewRequest(http.MethodPut, path, bytes.NewBuffer(jsonReqBody))
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeNotFound)
+	})
+
+	t.Run("投稿者でない場合にステータス403を返すこと", func(t *testing.T) {
+		// モック化
+		mockPostUsecase.EXPECT().Update(gomock.Any(), actor, int64(1), gomock.Any()).Return(nil, &apperror.ErrForbidden{Err: &authorization.ErrPermissionDenied{}})
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.PUT("/post/:id", setActor, h.Update)
+
+		// リクエスト設定
+		path := "/api/v1/post/1"
+		req := httptest.NewRequest(http.MethodPut, path, bytes.NewBuffer(jsonReqBody))
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeForbidden)
+	})
+
+	t.Run("idが不正な場合にステータス400を返すこと", func(t *testing.T) {
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.PUT("/post/:id", setActor, h.Update)
+
+		// リクエスト設定
+		path := "/api/v1/post/abc"
+		req := httptest.NewRequest(http.MethodPut, path, bytes.NewBuffer(jsonReqBody))
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeBadRequest)
+	})
+
+	t.Run("リクエストボディのバリデーションチェックでエラーの場合にステータス422を返すこと", func(t *testing.T) {
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.PUT("/post/:id", setActor, h.Update)
+
+		// リクエスト設定
+		path := "/api/v1/post/1"
+		req := httptest.NewRequest(http.MethodPut, path, bytes.NewBufferString(`{"text":""}`))
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeValidation)
+	})
+}
+
+func TestPostHandler_Delete(t *testing.T) {
+	// Ginのテストモードに設定
+	gin.SetMode(gin.TestMode)
+
+	// ユースケースのモック
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockPostUsecase := mockPost.NewMockPostUsecase(ctrl)
+
+	// 操作するユーザー（認可用のミドルウェアで設定される値）
+	actor := authorization.Actor{UID: "xxxx-xxxx-xxxx-0001", Role: domain_user.RoleMember}
+	setActor := func(c *gin.Context) {
+		c.Request = c.Request.WithContext(authorization.WithActor(c.Request.Context(), actor))
+		c.Next()
+	}
+
+	t.Run("ステータス200で正常終了すること", func(t *testing.T) {
+		// モック化
+		deletedAt := time.Now()
+		expectedPost := domain_post.ReconstitutePost(1, "こんにちは", "xxxx-xxxx-xxxx-0001", time.Time{}, deletedAt, &deletedAt)
+		mockPostUsecase.EXPECT().Delete(gomock.Any(), actor, int64(1)).Return(expectedPost, nil)
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.DELETE("/post/:id", setActor, h.Delete)
+
+		// リクエスト設定
+		path := "/api/v1/post/1"
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var data map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &data)
+		assert.NoError(t, err)
+
+		assert.Equal(t, float64(1), data["id"])
+		assert.NotNil(t, data["deleted_at"])
+	})
+
+	t.Run("対象のPostが存在しないまたは論理削除済みの場合にステータス404を返すこと", func(t *testing.T) {
+		// モック化
+		mockPostUsecase.EXPECT().Delete(gomock.Any(), actor, int64(1)).Return(nil, &apperror.ErrNotFound{Err: &usecase.ErrPostNotFound{}})
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.DELETE("/post/:id", setActor, h.Delete)
+
+		// リクエスト設定
+		path := "/api/v1/post/1"
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeNotFound)
+	})
+
+	t.Run("投稿者でない場合にステータス403を返すこと", func(t *testing.T) {
+		// モック化
+		mockPostUsecase.EXPECT().Delete(gomock.Any(), actor, int64(1)).Return(nil, &apperror.ErrForbidden{Err: &authorization.ErrPermissionDenied{}})
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.DELETE("/post/:id", setActor, h.Delete)
+
+		// リクエスト設定
+		path := "/api/v1/post/1"
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeForbidden)
+	})
+
+	t.Run("idが不正な場合にステータス400を返すこと", func(t *testing.T) {
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewPostHandler(mockPostUsecase)
+		apiV1.DELETE("/post/:id", setActor, h.Delete)
+
+		// リクエスト設定
+		path := "/api/v1/post/abc"
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeBadRequest)
+		assert.Contains(t, w.Body.String(), "IDは1以上の整数を指定して下さい。")
+	})
+}
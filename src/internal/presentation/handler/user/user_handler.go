@@ -1,11 +1,14 @@
 package user
 
 import (
-	"fmt"
+	"errors"
 	"net/http"
 	"strings"
 
 	usecase "go-gin-domain/internal/application/usecase/user"
+	"go-gin-domain/internal/domain/apperror"
+	domain_user "go-gin-domain/internal/domain/user"
+	"go-gin-domain/internal/presentation/handler"
 
 	"github.com/gin-gonic/gin"
 )
@@ -42,6 +45,24 @@ type UpdateUserRequestBody struct {
 	Email     string `json:"email" binding:"required,email"`
 }
 
+type FindAllUserQuery struct {
+	// 0を未指定と区別するためポインタにする
+	Limit          *int   `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor         string `form:"cursor"`
+	Sort           string `form:"sort" binding:"omitempty,oneof=created_at last_name email"`
+	Order          string `form:"order" binding:"omitempty,oneof=asc desc"`
+	Email          string `form:"email"`
+	Name           string `form:"name"`
+	IncludeDeleted bool   `form:"include_deleted"`
+}
+
+type FindAllUserResponse struct {
+	Users []*domain_user.User `json:"users"`
+	// 次のページが無い場合はnull
+	NextCursor *string `json:"next_cursor"`
+	HasMore    bool    `json:"has_more"`
+}
+
 func (h *userHandler) Create(c *gin.Context) {
 	// 共通コンテキスト
 	ctx := c.Request.Context()
@@ -49,19 +70,13 @@ func (h *userHandler) Create(c *gin.Context) {
 	// バリデーションチェック
 	var reqBody CreateUserRequestBody
 	if err := c.ShouldBindJSON(&reqBody); err != nil {
-		msg := fmt.Sprintf("バリデーションエラー: %s", err.Error())
-		c.JSON(http.StatusUnprocessableEntity, gin.H{
-			"message": msg,
-		})
+		handler.WriteBindError(c, err)
 		return
 	}
 
 	user, err := h.userUsecase.Create(ctx, reqBody.LastName, reqBody.FirstName, reqBody.Email)
 	if err != nil {
-		msg := fmt.Sprintf("Internal Server Error: %s", err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"message": msg,
-		})
+		handler.WriteError(c, err)
 		return
 	}
 
@@ -72,16 +87,40 @@ func (h *userHandler) FindAll(c *gin.Context) {
 	// 共通コンテキスト
 	ctx := c.Request.Context()
 
-	users, err := h.userUsecase.FindAll(ctx)
+	// バリデーションチェック
+	var query FindAllUserQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		handler.WriteBindError(c, err)
+		return
+	}
+
+	params := domain_user.FindAllParams{
+		Cursor:         query.Cursor,
+		SortField:      domain_user.SortField(query.Sort),
+		SortOrder:      domain_user.SortOrder(query.Order),
+		EmailPrefix:    query.Email,
+		NameContains:   query.Name,
+		IncludeDeleted: query.IncludeDeleted,
+	}
+	if query.Limit != nil {
+		params.Limit = *query.Limit
+	}
+
+	result, err := h.userUsecase.FindAll(ctx, params)
 	if err != nil {
-		msg := fmt.Sprintf("Internal Server Error: %s", err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"message": msg,
-		})
+		handler.WriteError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, users)
+	res := FindAllUserResponse{
+		Users:   result.Users,
+		HasMore: result.HasMore,
+	}
+	if result.NextCursor != "" {
+		res.NextCursor = &result.NextCursor
+	}
+
+	c.JSON(http.StatusOK, res)
 }
 
 func (h *userHandler) FindByUID(c *gin.Context) {
@@ -91,25 +130,13 @@ func (h *userHandler) FindByUID(c *gin.Context) {
 	// バリデーションチェック
 	uid := c.Param("uid")
 	if strings.TrimSpace(uid) == "" {
-		msg := fmt.Sprintf("バリデーションエラー: %s", "uid is required")
-		c.JSON(http.StatusUnprocessableEntity, gin.H{
-			"message": msg,
-		})
+		handler.WriteError(c, apperror.NewErrValidation("uid", errors.New("UIDを指定して下さい。")))
 		return
 	}
 
 	user, err := h.userUsecase.FindByUID(ctx, uid)
 	if err != nil {
-		msg := fmt.Sprintf("Internal Server Error: %s", err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"message": msg,
-		})
-		return
-	}
-
-	// userがnilの場合に空のオブジェクトを返す
-	if user == nil {
-		c.JSON(http.StatusOK, map[string]interface{}{})
+		handler.WriteError(c, err)
 		return
 	}
 
@@ -123,28 +150,19 @@ func (h *userHandler) Update(c *gin.Context) {
 	// バリデーションチェック
 	uid := c.Param("uid")
 	if strings.TrimSpace(uid) == "" {
-		msg := fmt.Sprintf("バリデーションエラー: %s", "uid is required")
-		c.JSON(http.StatusUnprocessableEntity, gin.H{
-			"message": msg,
-		})
+		handler.WriteError(c, apperror.NewErrValidation("uid", errors.New("UIDを指定して下さい。")))
 		return
 	}
 
 	var reqBody UpdateUserRequestBody
 	if err := c.ShouldBindJSON(&reqBody); err != nil {
-		msg := fmt.Sprintf("バリデーションエラー: %s", err.Error())
-		c.JSON(http.StatusUnprocessableEntity, gin.H{
-			"message": msg,
-		})
+		handler.WriteBindError(c, err)
 		return
 	}
 
 	user, err := h.userUsecase.Update(ctx, uid, reqBody.LastName, reqBody.FirstName, reqBody.Email)
 	if err != nil {
-		msg := fmt.Sprintf("Internal Server Error: %s", err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"message": msg,
-		})
+		handler.WriteError(c, err)
 		return
 	}
 
@@ -158,19 +176,13 @@ func (h *userHandler) Delete(c *gin.Context) {
 	// バリデーションチェック
 	uid := c.Param("uid")
 	if strings.TrimSpace(uid) == "" {
-		msg := fmt.Sprintf("バリデーションエラー: %s", "uid is required")
-		c.JSON(http.StatusUnprocessableEntity, gin.H{
-			"message": msg,
-		})
+		handler.WriteError(c, apperror.NewErrValidation("uid", errors.New("UIDを指定して下さい。")))
 		return
 	}
 
 	user, err := h.userUsecase.Delete(ctx, uid)
 	if err != nil {
-		msg := fmt.Sprintf("Internal Server Error: %s", err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"message": msg,
-		})
+		handler.WriteError(c, err)
 		return
 	}
 
@@ -10,58 +10,136 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
 	"testing"
+	"time"
 
+	"go-gin-domain/internal/application/usecase/authorization"
 	usecase_user "go-gin-domain/internal/application/usecase/user"
+	domain_user "go-gin-domain/internal/domain/user"
+	infra_auth "go-gin-domain/internal/infrastructure/auth"
 	"go-gin-domain/internal/infrastructure/database"
 	"go-gin-domain/internal/infrastructure/logger"
 	persistence_user "go-gin-domain/internal/infrastructure/persistence/user"
 	"go-gin-domain/internal/presentation/middleware"
+	"go-gin-domain/internal/presentation/problem"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/assert"
 )
 
+// 初期処理
+func init() {
+	// テスト用の環境変数ファイル「.env.testing」を読み込んで使用する。
+	if err := godotenv.Load("../../../../.env.testing"); err != nil {
+		fmt.Println(".env.testingの読み込みに失敗しました。")
+	}
+}
+
+// テスト用の管理者（初期化時に登録する）
+const (
+	testAdminUID   = "0196f1c2-7a3b-7c4d-8e5f-000000000001"
+	testAdminEmail = "admin@example.com"
+)
+
 // テスト用Ginの初期化処理
 func initTestGin() *gin.Engine {
+	return initTestGinWithLogOutput(os.Stdout)
+}
+
+// ログの出力先を指定してテスト用Ginを初期化する（ベンチマークではio.Discardを指定する）
+func initTestGinWithLogOutput(logOutput io.Writer) *gin.Engine {
 	// Ginのテストモードに設定
 	gin.SetMode(gin.TestMode)
 
-	// ハンドラーのインスタンス化
-	ctx := context.Background()
-	logger := logger.NewSlogLogger()
-	cfg := database.DummyConfig{
-		Dummy: "dummy",
+	// ハンドラーのインスタンス化（インメモリのリポジトリを利用）
+	logger := logger.NewSlogLogger(logger.NewSlogHandler(logger.Config{Output: logOutput}))
+	userRepo := persistence_user.NewMemoryUserRepository(logger)
+	txManager := database.NewMemoryTxManager()
+	userUsecase := usecase_user.NewUserUsecase(nil, txManager, userRepo, logger)
+	h := NewUserHandler(userUsecase)
+	authorizer := authorization.NewAuthorizer(nil, userRepo, authorization.DefaultPolicy(), logger)
+
+	// 管理者の登録（APIでは役割を変更できないため、リポジトリに直接登録する）
+	admin, err := domain_user.NewUser(testAdminUID, "管理", "者", testAdminEmail)
+	if err != nil {
+		panic(err)
+	}
+	admin.Role = domain_user.RoleAdmin
+	if _, err := userRepo.Create(context.Background(), nil, admin); err != nil {
+		panic(err)
 	}
-	db_dummy, err := database.NewDummyConnection(cfg, logger)
+
+	// JWT検証の設定
+	tokenVerifier, err := infra_auth.NewJWTVerifier(infra_auth.JWTConfig{
+		HMACSecret: os.Getenv("JWT_HMAC_SECRET"),
+		Issuer:     os.Getenv("JWT_ISSUER"),
+		Audience:   os.Getenv("JWT_AUDIENCE"),
+	})
 	if err != nil {
-		msg := fmt.Sprintf("エラー: %s", err.Error())
-		logger.Error(ctx, msg)
+		panic(err)
 	}
-	userRepo := persistence_user.NewUserRepository(logger)
-	userUsecase := usecase_user.NewUserUsecase(db_dummy, userRepo, logger)
-	h := NewUserHandler(userUsecase)
 
 	// ルーターの初期化
 	r := gin.New()
 
 	// ミドルウェアの設定
-	m := middleware.NewMiddleware()
+	m := middleware.NewMiddleware(tokenVerifier, authorizer, nil, logger, middleware.AccessLogConfig{}, false)
 	r.Use(m.Request())
-	r.Use(m.CustomLogger())
+	r.Use(m.AccessLog())
 	r.Use(gin.Recovery())
 
 	// ルーティング設定
 	apiV1 := r.Group("/api/v1")
 	apiV1.POST("/user", h.Create)
-	apiV1.GET("/users", m.Auth(), h.FindAll)
-	apiV1.GET("/user/:uid", m.Auth(), h.FindByUID)
-	apiV1.PUT("/user/:uid", m.Auth(), h.Update)
-	apiV1.DELETE("/user/:uid", m.Auth(), h.Delete)
+	apiV1.GET("/users", m.Auth(), m.RequirePermission(authorization.PermissionListUsers), h.FindAll)
+	apiV1.GET("/user/:uid", m.Auth(), m.RequirePermission(authorization.PermissionReadUser), h.FindByUID)
+	apiV1.PUT("/user/:uid", m.Auth(), m.RequirePermission(authorization.PermissionUpdateUser), h.Update)
+	apiV1.DELETE("/user/:uid", m.Auth(), m.RequirePermission(authorization.PermissionDeleteUser), h.Delete)
 
 	return r
 }
 
+// テスト用の認証トークンを作成
+func newTestToken(t *testing.T, uid string) string {
+	t.Helper()
+	claims := jwt.RegisteredClaims{
+		Subject:   uid,
+		Issuer:    os.Getenv("JWT_ISSUER"),
+		Audience:  jwt.ClaimStrings{os.Getenv("JWT_AUDIENCE")},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(os.Getenv("JWT_HMAC_SECRET")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// テスト用のユーザーを作成し、レスポンスを返す
+func createTestUser(t *testing.T, r *gin.Engine, reqBody CreateUserRequestBody) map[string]interface{} {
+	t.Helper()
+	jsonReqBody, err := json.Marshal(reqBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/user", bytes.NewBuffer(jsonReqBody))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("ユーザーの作成に失敗しました。: status=%d", w.Code)
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &data); err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
 func TestUserHandler_Create_Integration(t *testing.T) {
 	// ルーター設定
 	r := initTestGin()
@@ -96,21 +174,349 @@ func TestUserHandler_Create_Integration(t *testing.T) {
 		assert.Equal(t, reqBody.LastName, data["last_name"])
 		assert.Equal(t, reqBody.FirstName, data["first_name"])
 		assert.Equal(t, reqBody.Email, data["email"])
+		assert.Equal(t, "member", data["role"])
 		assert.NotNil(t, data["created_at"])
 		assert.NotNil(t, data["updated_at"])
 		assert.Nil(t, data["deleted_at"])
 	})
 }
 
+func TestUserHandler_FindAll_Pagination_Integration(t *testing.T) {
+	// ルーター設定
+	r := initTestGin()
+
+	// 事前にユーザーを作成
+	for _, reqBody := range []CreateUserRequestBody{
+		{LastName: "田中", FirstName: "太郎", Email: "t.tanaka@example.com"},
+		{LastName: "佐藤", FirstName: "一郎", Email: "i.satou@example.com"},
+		{LastName: "鈴木", FirstName: "花子", Email: "h.suzuki@example.com"},
+		{LastName: "田村", FirstName: "次郎", Email: "j.tamura@example.com"},
+	} {
+		createTestUser(t, r, reqBody)
+	}
+	// 一覧の取得は管理者のみ可能
+	token := newTestToken(t, testAdminUID)
+
+	// 一覧を取得する
+	findAll := func(t *testing.T, query url.Values) FindAllUserResponse {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users?"+query.Encode(), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("一覧の取得に失敗しました。: status=%d, body=%s", w.Code, w.Body.String())
+		}
+
+		var res FindAllUserResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	t.Run("カーソルで全件を重複なく取得できること", func(t *testing.T) {
+		query := url.Values{"limit": {"3"}, "sort": {"email"}, "order": {"desc"}}
+
+		// テストの実行
+		first := findAll(t, query)
+		assert.True(t, first.HasMore)
+		assert.NotNil(t, first.NextCursor)
+
+		query.Set("cursor", *first.NextCursor)
+		second := findAll(t, query)
+		assert.False(t, second.HasMore)
+		assert.Nil(t, second.NextCursor)
+
+		// 検証
+		var emails []string
+		for _, u := range append(first.Users, second.Users...) {
+			emails = append(emails, u.Email)
+		}
+		assert.Equal(t, []string{"t.tanaka@example.com", "j.tamura@example.com", "i.satou@example.com", "h.suzuki@example.com", testAdminEmail}, emails)
+	})
+
+	t.Run("絞り込み条件で取得できること", func(t *testing.T) {
+		// テストの実行
+		byEmail := findAll(t, url.Values{"email": {"T."}})
+		byName := findAll(t, url.Values{"name": {"田"}, "sort": {"created_at"}})
+
+		// 検証
+		assert.Len(t, byEmail.Users, 1)
+		assert.Equal(t, "t.tanaka@example.com", byEmail.Users[0].Email)
+		assert.Len(t, byName.Users, 2)
+		assert.Equal(t, "田中", byName.Users[0].LastName)
+		assert.Equal(t, "田村", byName.Users[1].LastName)
+	})
+
+	t.Run("並び替え条件と異なるカーソルの場合にステータス400を返すこと", func(t *testing.T) {
+		first := findAll(t, url.Values{"limit": {"1"}, "sort": {"email"}})
+
+		// リクエスト設定
+		query := url.Values{"limit": {"1"}, "sort": {"last_name"}, "cursor": {*first.NextCursor}}
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users?"+query.Encode(), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeBadRequest)
+	})
+}
+
+func TestUserHandler_UniqueEmail_Integration(t *testing.T) {
+	// ルーター設定
+	r := initTestGin()
+
+	// 事前にユーザーを作成
+	created := createTestUser(t, r, CreateUserRequestBody{
+		LastName:  "田中",
+		FirstName: "太郎",
+		Email:     "t.tanaka@example.com",
+	})
+	uid := created["uid"].(string)
+	token := newTestToken(t, uid)
+
+	// ユーザー作成のリクエストを実行する
+	create := func(email string) *httptest.ResponseRecorder {
+		jsonReqBody, err := json.Marshal(CreateUserRequestBody{LastName: "佐藤", FirstName: "一郎", Email: email})
+		if err != nil {
+			t.Fatal(err)
+		}
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/user", bytes.NewBuffer(jsonReqBody))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("有効なユーザーと同じメールアドレスの場合にステータス409を返すこと", func(t *testing.T) {
+		// テストの実行（大文字と小文字の違いは同じメールアドレスとみなす）
+		w := create("T.Tanaka@example.com")
+
+		// 検証
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "このメールアドレスは既に登録されています。")
+	})
+
+	t.Run("削除済みのユーザーと同じメールアドレスの場合は登録できること", func(t *testing.T) {
+		// 事前にユーザーを削除
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/user/"+uid, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		// テストの実行
+		w = create("t.tanaka@example.com")
+
+		// 検証
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+}
+
+func TestUserHandler_CreateThenRead_Integration(t *testing.T) {
+	// ルーター設定
+	r := initTestGin()
+
+	// 事前にユーザーを作成
+	created := createTestUser(t, r, CreateUserRequestBody{
+		LastName:  "田中",
+		FirstName: "太郎",
+		Email:     "t.tanaka@example.com",
+	})
+	uid := created["uid"].(string)
+	token := newTestToken(t, uid)
+
+	t.Run("作成したユーザーをUIDで取得できること", func(t *testing.T) {
+		// リクエスト設定
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/user/"+uid, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var data map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &data)
+		assert.NoError(t, err)
+		assert.Equal(t, uid, data["uid"])
+		assert.Equal(t, "田中", data["last_name"])
+		assert.Equal(t, "t.tanaka@example.com", data["email"])
+	})
+
+	t.Run("作成したユーザーが一覧に含まれること（管理者の場合）", func(t *testing.T) {
+		// リクエスト設定
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users?sort=created_at", nil)
+		req.Header.Set("Authorization", "Bearer "+newTestToken(t, testAdminUID))
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var res FindAllUserResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Len(t, res.Users, 2)
+		assert.Equal(t, testAdminUID, res.Users[0].UID)
+		assert.Equal(t, uid, res.Users[1].UID)
+		assert.Nil(t, res.NextCursor)
+		assert.False(t, res.HasMore)
+	})
+
+	t.Run("更新した内容が取得結果に反映されること", func(t *testing.T) {
+		// リクエスト設定
+		reqBody := UpdateUserRequestBody{
+			LastName:  "佐藤",
+			FirstName: "二郎",
+			Email:     "z.satou@example.com",
+		}
+		jsonReqBody, err := json.Marshal(reqBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/user/"+uid, bytes.NewBuffer(jsonReqBody))
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/user/"+uid, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var data map[string]interface{}
+		err = json.Unmarshal(w.Body.Bytes(), &data)
+		assert.NoError(t, err)
+		assert.Equal(t, reqBody.LastName, data["last_name"])
+		assert.Equal(t, reqBody.FirstName, data["first_name"])
+		assert.Equal(t, reqBody.Email, data["email"])
+	})
+
+	t.Run("削除したユーザーは取得できないこと", func(t *testing.T) {
+		// リクエスト設定
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/user/"+uid, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		// 管理者の認証トークンで取得する
+		adminToken := newTestToken(t, testAdminUID)
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/user/"+uid, nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeNotFound)
+
+		// 削除済みのユーザーは更新および削除もできないこと
+		req = httptest.NewRequest(http.MethodDelete, "/api/v1/user/"+uid, nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		// 削除済みのユーザーの認証トークンでは操作できないこと
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/user/"+uid, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestUserHandler_Authorization_Integration(t *testing.T) {
+	// ルーター設定
+	r := initTestGin()
+
+	// 事前にユーザーを作成
+	self := createTestUser(t, r, CreateUserRequestBody{LastName: "田中", FirstName: "太郎", Email: "t.tanaka@example.com"})
+	other := createTestUser(t, r, CreateUserRequestBody{LastName: "佐藤", FirstName: "一郎", Email: "i.satou@example.com"})
+	selfUID := self["uid"].(string)
+	otherUID := other["uid"].(string)
+
+	// 認証トークンを指定してリクエストを実行する
+	do := func(t *testing.T, method, path, actorUID string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		var reqBody io.Reader
+		if body != nil {
+			jsonReqBody, err := json.Marshal(body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			reqBody = bytes.NewBuffer(jsonReqBody)
+		}
+		req := httptest.NewRequest(method, path, reqBody)
+		req.Header.Set("Authorization", "Bearer "+newTestToken(t, actorUID))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	updateBody := UpdateUserRequestBody{LastName: "佐藤", FirstName: "二郎", Email: "j.satou@example.com"}
+
+	t.Run("memberは自分自身を取得できること", func(t *testing.T) {
+		w := do(t, http.MethodGet, "/api/v1/user/"+selfUID, selfUID, nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("memberは他のユーザーを操作できずステータス403を返すこと", func(t *testing.T) {
+		for _, w := range []*httptest.ResponseRecorder{
+			do(t, http.MethodGet, "/api/v1/users", selfUID, nil),
+			do(t, http.MethodGet, "/api/v1/user/"+otherUID, selfUID, nil),
+			do(t, http.MethodPut, "/api/v1/user/"+otherUID, selfUID, updateBody),
+			do(t, http.MethodDelete, "/api/v1/user/"+otherUID, selfUID, nil),
+		} {
+			assert.Equal(t, http.StatusForbidden, w.Code)
+			assert.Contains(t, w.Body.String(), problem.TypeForbidden)
+		}
+	})
+
+	t.Run("未登録のユーザーの場合はステータス403を返すこと", func(t *testing.T) {
+		unknownUID := "0196f1c2-7a3b-7c4d-8e5f-0000000000ff"
+		w := do(t, http.MethodGet, "/api/v1/user/"+unknownUID, unknownUID, nil)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeForbidden)
+	})
+
+	t.Run("adminは他のユーザーを更新および削除できること", func(t *testing.T) {
+		w := do(t, http.MethodPut, "/api/v1/user/"+otherUID, testAdminUID, updateBody)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = do(t, http.MethodDelete, "/api/v1/user/"+otherUID, testAdminUID, nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = do(t, http.MethodGet, "/api/v1/user/"+otherUID, testAdminUID, nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
 /******************************
  * ベンチマーク関数を追加
  ******************************/
 func BenchmarkUserHandler_Create_Integration(b *testing.B) {
-	// ルーター設定
-	r := initTestGin()
-
-	// ログ出力を無効化する
-	gin.DefaultWriter = io.Discard
+	// ルーター設定（アクセスログを含めてログ出力を無効化する）
+	r := initTestGinWithLogOutput(io.Discard)
 
 	// リクエストパス設定
 	path := "/api/v1/user"
@@ -123,8 +529,9 @@ func BenchmarkUserHandler_Create_Integration(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		// ループごとに新しいリクエストとレスポンスレコーダーを作成
 		// これらは１回のHTTPリクエストに相当するため、ループ内で都度生成する
-		lastName := fmt.Sprintf("田中%d", i)
-		firstName := fmt.Sprintf("太郎%d", i)
+		// 名前に数字は使用できないため、メールアドレスのみ一意にする
+		lastName := "田中"
+		firstName := "太郎"
 		email := fmt.Sprintf("t.tanaka%d@example.com", i)
 		reqBody := CreateUserRequestBody{
 			LastName:  lastName,
@@ -8,12 +8,20 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
+	"go-gin-domain/internal/application/usecase/auth"
+	mockAuth "go-gin-domain/internal/application/usecase/auth/mock_auth"
+	usecase "go-gin-domain/internal/application/usecase/user"
 	mockUser "go-gin-domain/internal/application/usecase/user/mock_user"
+	"go-gin-domain/internal/domain/apperror"
+	"go-gin-domain/internal/domain/repository"
 	domain_user "go-gin-domain/internal/domain/user"
+	"go-gin-domain/internal/infrastructure/logger"
 	"go-gin-domain/internal/presentation/middleware"
+	"go-gin-domain/internal/presentation/problem"
 
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
@@ -29,14 +37,17 @@ func init() {
 	}
 }
 
+// アクセスログ用のロガー
+var testLogger = logger.NewSlogLogger(logger.NewSlogHandler(logger.Config{}))
+
 // テスト用Ginの初期化処理
 func initTestGin() (*gin.Engine, *gin.RouterGroup) {
 	r := gin.New()
 
 	// ミドルウェアの設定
-	m := middleware.NewMiddleware()
+	m := middleware.NewMiddleware(nil, nil, nil, testLogger, middleware.AccessLogConfig{}, false)
 	r.Use(m.Request())
-	r.Use(m.CustomLogger())
+	r.Use(m.AccessLog())
 	r.Use(gin.Recovery())
 
 	apiV1 := r.Group("/api/v1")
@@ -135,7 +146,41 @@ func TestUserHandler_Create(t *testing.T) {
 
 		// 検証
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
-		assert.Contains(t, w.Body.String(), "Internal Server Error")
+		assert.Contains(t, w.Body.String(), problem.TypeInternal)
+	})
+
+	t.Run("ドメインのバリデーションエラーの場合にステータス422を返すこと", func(t *testing.T) {
+		// モック化
+		err := &apperror.ErrValidation{Errors: []*apperror.FieldError{
+			{Field: "last_name", Err: &domain_user.ErrInvalidNameCharacter{}},
+		}}
+		mockUserUsecase.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, err)
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewUserHandler(mockUserUsecase)
+		apiV1.POST("/user", h.Create)
+
+		// リクエスト設定
+		path := "/api/v1/user"
+		reqBody := CreateUserRequestBody{
+			LastName:  "田中1",
+			FirstName: "太郎",
+			Email:     "t.tanaka@example.com",
+		}
+		jsonReqBody, jsonErr := json.Marshal(reqBody)
+		if jsonErr != nil {
+			t.Fatal(jsonErr)
+		}
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBuffer(jsonReqBody))
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), `{"field":"last_name","message":"名前に使用できない文字が含まれています。"}`)
 	})
 
 	t.Run("バリデーションチェックでエラーの場合にステータス422を返すこと", func(t *testing.T) {
@@ -163,7 +208,107 @@ func TestUserHandler_Create(t *testing.T) {
 
 		// 検証
 		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
-		assert.Contains(t, w.Body.String(), "バリデーションエラー")
+		assert.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+
+		var data problem.Problem
+		err = json.Unmarshal(w.Body.Bytes(), &data)
+		assert.NoError(t, err)
+		assert.Equal(t, problem.TypeValidation, data.Type)
+		assert.Equal(t, http.StatusUnprocessableEntity, data.Status)
+		assert.NotEmpty(t, data.Instance)
+		assert.Equal(t, []problem.FieldError{{Field: "last_name", Message: "last_nameは必須フィールドです"}}, data.Errors)
+	})
+
+	t.Run("Accept-Languageが英語の場合に英語のメッセージを返すこと", func(t *testing.T) {
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewUserHandler(mockUserUsecase)
+		apiV1.POST("/user", h.Create)
+
+		// リクエスト設定
+		path := "/api/v1/user"
+		reqBody := CreateUserRequestBody{
+			LastName:  "",
+			FirstName: "太郎",
+			Email:     "t.tanaka@example.com",
+		}
+		jsonReqBody, err := json.Marshal(reqBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBuffer(jsonReqBody))
+		req.Header.Set("Accept-Language", "en-US,en;q=0.9,ja;q=0.8")
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+		var data problem.Problem
+		err = json.Unmarshal(w.Body.Bytes(), &data)
+		assert.NoError(t, err)
+		assert.Equal(t, "The request contains invalid values.", data.Title)
+		assert.Equal(t, []problem.FieldError{{Field: "last_name", Message: "last_name is a required field"}}, data.Errors)
+	})
+
+	t.Run("Accept-Languageが英語の場合にドメインのエラーを英語で返すこと", func(t *testing.T) {
+		// モック化
+		err := &apperror.ErrValidation{Errors: []*apperror.FieldError{
+			{Field: "last_name", Err: &domain_user.ErrInvalidNameLength{Max: 50}},
+		}}
+		mockUserUsecase.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, err)
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewUserHandler(mockUserUsecase)
+		apiV1.POST("/user", h.Create)
+
+		// リクエスト設定
+		path := "/api/v1/user"
+		reqBody := CreateUserRequestBody{
+			LastName:  "田中",
+			FirstName: "太郎",
+			Email:     "t.tanaka@example.com",
+		}
+		jsonReqBody, jsonErr := json.Marshal(reqBody)
+		if jsonErr != nil {
+			t.Fatal(jsonErr)
+		}
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBuffer(jsonReqBody))
+		req.Header.Set("Accept-Language", "en")
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), `{"field":"last_name","message":"Name must be 50 characters or fewer."}`)
+	})
+
+	t.Run("リクエストボディの形式が不正な場合にステータス422を返すこと", func(t *testing.T) {
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewUserHandler(mockUserUsecase)
+		apiV1.POST("/user", h.Create)
+
+		// リクエスト設定
+		path := "/api/v1/user"
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(`{"last_name": 1}`))
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+		var data problem.Problem
+		err := json.Unmarshal(w.Body.Bytes(), &data)
+		assert.NoError(t, err)
+		assert.Equal(t, []problem.FieldError{{Field: "last_name", Message: "値の型が不正です。"}}, data.Errors)
 	})
 }
 
@@ -176,6 +321,10 @@ func TestUserHandler_FindAll(t *testing.T) {
 	defer ctrl.Finish()
 	mockUserUsecase := mockUser.NewMockUserUsecase(ctrl)
 
+	// 認証のモック
+	mockTokenVerifier := mockAuth.NewMockTokenVerifier(ctrl)
+	mockTokenVerifier.EXPECT().Verify(gomock.Any(), gomock.Any()).Return(&auth.Claims{Subject: "xxxx-xxxx-xxxx-0001"}, nil).AnyTimes()
+
 	t.Run("ステータス200で正常終了すること", func(t *testing.T) {
 		// モック化
 		expectedUsers := []*domain_user.User{
@@ -200,16 +349,27 @@ func TestUserHandler_FindAll(t *testing.T) {
 				DeletedAt: nil,
 			},
 		}
-		mockUserUsecase.EXPECT().FindAll(gomock.Any()).Return(expectedUsers, nil)
+		expectedParams := domain_user.FindAllParams{
+			Limit:        2,
+			SortField:    domain_user.SortByLastName,
+			SortOrder:    domain_user.SortDesc,
+			EmailPrefix:  "t.",
+			NameContains: "田",
+		}
+		mockUserUsecase.EXPECT().FindAll(gomock.Any(), expectedParams).Return(&domain_user.FindAllResult{
+			Users:      expectedUsers,
+			NextCursor: "xxxxxx",
+			HasMore:    true,
+		}, nil)
 
 		// ルーター設定
 		r, apiV1 := initTestGin()
-		m := middleware.NewMiddleware()
+		m := middleware.NewMiddleware(mockTokenVerifier, nil, nil, testLogger, middleware.AccessLogConfig{}, false)
 		h := NewUserHandler(mockUserUsecase)
 		apiV1.GET("/users", m.Auth(), h.FindAll)
 
 		// リクエスト設定
-		path := "/api/v1/users"
+		path := "/api/v1/users?limit=2&sort=last_name&order=desc&email=t.&name=" + url.QueryEscape("田")
 		req := httptest.NewRequest(http.MethodGet, path, nil)
 		req.Header.Set("Authorization", "Bearer xxxxxx")
 
@@ -220,9 +380,17 @@ func TestUserHandler_FindAll(t *testing.T) {
 		// 検証
 		assert.Equal(t, http.StatusOK, w.Code)
 
-		var list []map[string]interface{}
-		err := json.Unmarshal(w.Body.Bytes(), &list)
+		var res struct {
+			Users      []map[string]interface{} `json:"users"`
+			NextCursor *string                  `json:"next_cursor"`
+			HasMore    bool                     `json:"has_more"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &res)
 		assert.NoError(t, err)
+		assert.Equal(t, "xxxxxx", *res.NextCursor)
+		assert.True(t, res.HasMore)
+
+		list := res.Users
 
 		assert.NotContains(t, list[0], "id")
 		assert.Equal(t, expectedUsers[0].UID, list[0]["uid"])
@@ -246,11 +414,11 @@ func TestUserHandler_FindAll(t *testing.T) {
 	t.Run("ユースケースでエラーが発生した場合にステータス500を返すこと", func(t *testing.T) {
 		// モック化
 		err := fmt.Errorf("Internal Server Error")
-		mockUserUsecase.EXPECT().FindAll(gomock.Any()).Return(nil, err)
+		mockUserUsecase.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, err)
 
 		// ルーター設定
 		r, apiV1 := initTestGin()
-		m := middleware.NewMiddleware()
+		m := middleware.NewMiddleware(mockTokenVerifier, nil, nil, testLogger, middleware.AccessLogConfig{}, false)
 		h := NewUserHandler(mockUserUsecase)
 		apiV1.GET("/users", m.Auth(), h.FindAll)
 
@@ -265,7 +433,80 @@ func TestUserHandler_FindAll(t *testing.T) {
 
 		// 検証
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
-		assert.Contains(t, w.Body.String(), "Internal Server Error")
+		assert.Contains(t, w.Body.String(), problem.TypeInternal)
+	})
+
+	t.Run("次のページが無い場合にnext_cursorがnullになること", func(t *testing.T) {
+		// モック化
+		mockUserUsecase.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&domain_user.FindAllResult{
+			Users: []*domain_user.User{},
+		}, nil)
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		m := middleware.NewMiddleware(mockTokenVerifier, nil, nil, testLogger, middleware.AccessLogConfig{}, false)
+		h := NewUserHandler(mockUserUsecase)
+		apiV1.GET("/users", m.Auth(), h.FindAll)
+
+		// リクエスト設定
+		path := "/api/v1/users"
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer xxxxxx")
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"users":[],"next_cursor":null,"has_more":false}`, w.Body.String())
+	})
+
+	t.Run("クエリパラメータが不正な場合にステータス422を返すこと", func(t *testing.T) {
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		m := middleware.NewMiddleware(mockTokenVerifier, nil, nil, testLogger, middleware.AccessLogConfig{}, false)
+		h := NewUserHandler(mockUserUsecase)
+		apiV1.GET("/users", m.Auth(), h.FindAll)
+
+		for _, query := range []string{"limit=0", "limit=101", "sort=uid", "order=xxx"} {
+			// リクエスト設定
+			path := "/api/v1/users?" + query
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			req.Header.Set("Authorization", "Bearer xxxxxx")
+
+			// テストの実行
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// 検証
+			assert.Equal(t, http.StatusUnprocessableEntity, w.Code, query)
+			assert.Contains(t, w.Body.String(), problem.TypeValidation)
+		}
+	})
+
+	t.Run("カーソルが不正な場合にステータス400を返すこと", func(t *testing.T) {
+		// モック化
+		mockUserUsecase.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, &apperror.ErrBadRequest{Err: &repository.ErrInvalidCursor{}})
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		m := middleware.NewMiddleware(mockTokenVerifier, nil, nil, testLogger, middleware.AccessLogConfig{}, false)
+		h := NewUserHandler(mockUserUsecase)
+		apiV1.GET("/users", m.Auth(), h.FindAll)
+
+		// リクエスト設定
+		path := "/api/v1/users?cursor=xxxxxx"
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer xxxxxx")
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeBadRequest)
 	})
 }
 
@@ -278,6 +519,10 @@ func TestUserHandler_FindByUID(t *testing.T) {
 	defer ctrl.Finish()
 	mockUserUsecase := mockUser.NewMockUserUsecase(ctrl)
 
+	// 認証のモック
+	mockTokenVerifier := mockAuth.NewMockTokenVerifier(ctrl)
+	mockTokenVerifier.EXPECT().Verify(gomock.Any(), gomock.Any()).Return(&auth.Claims{Subject: "xxxx-xxxx-xxxx-0001"}, nil).AnyTimes()
+
 	t.Run("ステータス200で正常終了すること", func(t *testing.T) {
 		// モック化
 		expectedUser := &domain_user.User{
@@ -294,7 +539,7 @@ func TestUserHandler_FindByUID(t *testing.T) {
 
 		// ルーター設定
 		r, apiV1 := initTestGin()
-		m := middleware.NewMiddleware()
+		m := middleware.NewMiddleware(mockTokenVerifier, nil, nil, testLogger, middleware.AccessLogConfig{}, false)
 		h := NewUserHandler(mockUserUsecase)
 		apiV1.GET("/user/:uid", m.Auth(), h.FindByUID)
 
@@ -324,13 +569,14 @@ func TestUserHandler_FindByUID(t *testing.T) {
 		assert.Nil(t, data["deleted_at"])
 	})
 
-	t.Run("対象ユーザーが存在しない場合にステータス200で空のオブジェクトを返すこと", func(t *testing.T) {
+	t.Run("対象ユーザーが存在しない場合にステータス404を返すこと", func(t *testing.T) {
 		// モック化
-		mockUserUsecase.EXPECT().FindByUID(gomock.Any(), gomock.Any()).Return(nil, nil)
+		err := &apperror.ErrNotFound{Err: &usecase.ErrUserNotFound{}}
+		mockUserUsecase.EXPECT().FindByUID(gomock.Any(), gomock.Any()).Return(nil, err)
 
 		// ルーター設定
 		r, apiV1 := initTestGin()
-		m := middleware.NewMiddleware()
+		m := middleware.NewMiddleware(mockTokenVerifier, nil, nil, testLogger, middleware.AccessLogConfig{}, false)
 		h := NewUserHandler(mockUserUsecase)
 		apiV1.GET("/user/:uid", m.Auth(), h.FindByUID)
 
@@ -344,22 +590,18 @@ func TestUserHandler_FindByUID(t *testing.T) {
 		r.ServeHTTP(w, req)
 
 		// 検証
-		assert.Equal(t, http.StatusOK, w.Code)
-
-		var data map[string]interface{}
-		err := json.Unmarshal(w.Body.Bytes(), &data)
-		assert.NoError(t, err)
-		assert.Empty(t, data)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), `"detail":"対象ユーザーが存在しません。"`)
 	})
 
 	t.Run("ユースケースでエラーが発生した場合にステータス500を返すこと", func(t *testing.T) {
 		// モック化
-		err := fmt.Errorf("Internal Server Error")
+		err := fmt.Errorf("ユーザーの取得に失敗しました。: connection refused")
 		mockUserUsecase.EXPECT().FindByUID(gomock.Any(), gomock.Any()).Return(nil, err)
 
 		// ルーター設定
 		r, apiV1 := initTestGin()
-		m := middleware.NewMiddleware()
+		m := middleware.NewMiddleware(mockTokenVerifier, nil, nil, testLogger, middleware.AccessLogConfig{}, false)
 		h := NewUserHandler(mockUserUsecase)
 		apiV1.GET("/user/:uid", m.Auth(), h.FindByUID)
 
@@ -374,13 +616,15 @@ func TestUserHandler_FindByUID(t *testing.T) {
 
 		// 検証
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
-		assert.Contains(t, w.Body.String(), "Internal Server Error")
+		assert.Contains(t, w.Body.String(), problem.TypeInternal)
+		// 内部エラーの詳細は返さないこと
+		assert.NotContains(t, w.Body.String(), "connection refused")
 	})
 
 	t.Run("バリデーションチェックでエラーの場合にステータス422を返すこと", func(t *testing.T) {
 		// ルーター設定
 		r, apiV1 := initTestGin()
-		m := middleware.NewMiddleware()
+		m := middleware.NewMiddleware(mockTokenVerifier, nil, nil, testLogger, middleware.AccessLogConfig{}, false)
 		h := NewUserHandler(mockUserUsecase)
 		apiV1.GET("/user/:uid", m.Auth(), h.FindByUID)
 
@@ -395,7 +639,7 @@ func TestUserHandler_FindByUID(t *testing.T) {
 
 		// 検証
 		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
-		assert.Contains(t, w.Body.String(), "バリデーションエラー")
+		assert.Contains(t, w.Body.String(), problem.TypeValidation)
 	})
 }
 
@@ -493,7 +737,7 @@ func TestUserHandler_Update(t *testing.T) {
 
 		// 検証
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
-		assert.Contains(t, w.Body.String(), "Internal Server Error")
+		assert.Contains(t, w.Body.String(), problem.TypeInternal)
 	})
 
 	t.Run("UIDのバリデーションチェックでエラーの場合にステータス422を返すこと", func(t *testing.T) {
@@ -522,7 +766,7 @@ func TestUserHandler_Update(t *testing.T) {
 
 		// 検証
 		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
-		assert.Contains(t, w.Body.String(), "バリデーションエラー")
+		assert.Contains(t, w.Body.String(), problem.TypeValidation)
 	})
 
 	t.Run("リクエストボディのバリデーションチェックでエラーの場合にステータス422を返すこと", func(t *testing.T) {
@@ -551,7 +795,7 @@ func TestUserHandler_Update(t *testing.T) {
 
 		// 検証
 		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
-		assert.Contains(t, w.Body.String(), "バリデーションエラー")
+		assert.Contains(t, w.Body.String(), problem.TypeValidation)
 	})
 }
 
@@ -635,7 +879,31 @@ func TestUserHandler_Delete(t *testing.T) {
 
 		// 検証
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
-		assert.Contains(t, w.Body.String(), "Internal Server Error")
+		assert.Contains(t, w.Body.String(), problem.TypeInternal)
+	})
+
+	t.Run("対象ユーザーが存在しない場合にステータス404を返すこと", func(t *testing.T) {
+		// モック化
+		err := &apperror.ErrNotFound{Err: &usecase.ErrUserNotFound{}}
+		mockUserUsecase.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil, err)
+
+		// ルーター設定
+		r, apiV1 := initTestGin()
+		h := NewUserHandler(mockUserUsecase)
+		apiV1.DELETE("/user/:uid", h.Delete)
+
+		// リクエスト設定
+		path := "/api/v1/user/xxxx-xxxx-xxxx-0002"
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+		req.Header.Set("Authorization", "Bearer xxxxxx")
+
+		// テストの実行
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeNotFound)
 	})
 
 	t.Run("バリデーションチェックでエラーの場合にステータス422を返すこと", func(t *testing.T) {
@@ -655,6 +923,6 @@ func TestUserHandler_Delete(t *testing.T) {
 
 		// 検証
 		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
-		assert.Contains(t, w.Body.String(), "バリデーションエラー")
+		assert.Contains(t, w.Body.String(), problem.TypeValidation)
 	})
 }
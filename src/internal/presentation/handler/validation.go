@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"reflect"
+	"strings"
+
+	"go-gin-domain/internal/presentation/i18n"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/ja"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	ja_translations "github.com/go-playground/validator/v10/translations/ja"
+)
+
+// バリデーションエラーのメッセージの翻訳（日本語をデフォルトとする）
+var uni = ut.New(ja.New(), ja.New(), en.New())
+
+func init() {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+
+	// バリデーションエラーの項目名に構造体のフィールド名ではなくリクエストの項目名を利用する
+	v.RegisterTagNameFunc(fieldName)
+
+	// 言語ごとのメッセージを登録する
+	jaTrans, _ := uni.GetTranslator("ja")
+	enTrans, _ := uni.GetTranslator("en")
+	if err := ja_translations.RegisterDefaultTranslations(v, jaTrans); err != nil {
+		panic(err)
+	}
+	if err := en_translations.RegisterDefaultTranslations(v, enTrans); err != nil {
+		panic(err)
+	}
+}
+
+// jsonタグ（クエリパラメータの場合はformタグ）の名前を取得する
+func fieldName(field reflect.StructField) string {
+	for _, tag := range []string{"json", "form"} {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+// リクエストの言語に対応するバリデーションエラーの翻訳
+func translator(l *i18n.Localizer) ut.Translator {
+	base, _ := l.Tag().Base()
+	trans, _ := uni.GetTranslator(base.String())
+	return trans
+}
@@ -0,0 +1,67 @@
+package i18n
+
+import (
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+// メッセージカタログ（日本語のメッセージをキーとし、英語の翻訳を登録する）
+var messages = newCatalog()
+
+// 日本語のメッセージと英語の翻訳
+var english = map[string]string{
+	// エラーの種類
+	"リクエストが不正です。":        "The request is invalid.",
+	"入力値が不正です。":          "The request contains invalid values.",
+	"認証に失敗しました。":         "Authentication failed.",
+	"操作する権限がありません。":      "You do not have permission to perform this operation.",
+	"対象が存在しません。":         "The requested resource was not found.",
+	"既存のデータと競合しています。":    "The request conflicts with existing data.",
+	"サーバー内部でエラーが発生しました。": "An internal server error occurred.",
+
+	// リクエスト
+	"リクエストの形式が不正です。":     "The request body is malformed.",
+	"値の型が不正です。":          "The value has an invalid type.",
+	"UIDを指定して下さい。":       "UID is required.",
+	"IDは1以上の整数を指定して下さい。": "ID must be an integer greater than or equal to 1.",
+	"カーソルの値が不正です。":       "The cursor is invalid.",
+
+	// 認証
+	"認証用トークンが設定されていません。":   "The authentication token is missing.",
+	"認証用トークンの形式が不正です。":     "The authentication token is malformed.",
+	"認証用トークンの有効期限が切れています。": "The authentication token has expired.",
+	"認証用トークンが無効です。":        "The authentication token is invalid.",
+
+	// 認可
+	"この操作は許可されていません。":     "This operation is not permitted.",
+	"操作するユーザーが登録されていません。": "The authenticated user is not registered.",
+
+	// user
+	"名前を入力して下さい。":           "Name is required.",
+	"名前は%d文字以下にして下さい。":      "Name must be %d characters or fewer.",
+	"名前に使用できない文字が含まれています。":  "Name contains characters that are not allowed.",
+	"メールアドレスを入力して下さい。":      "Email is required.",
+	"メールアドレスの形式が不正です。":      "Email is not a valid email address.",
+	"このメールアドレスは既に登録されています。": "This email address is already registered.",
+	"UIDの形式が不正です。":          "UID has an invalid format.",
+	"対象ユーザーが存在しません。":        "The user was not found.",
+
+	// post
+	"文字数は%d文字以下にして下さい。":    "Text must be %d characters or fewer.",
+	"本文を入力して下さい。":          "Text is required.",
+	"本文に使用できない文字が含まれています。": "Text contains characters that are not allowed.",
+	"対象のPostが存在しません。":      "The post was not found.",
+
+	// post（一覧取得）
+	"created_beforeはcreated_afterより後の日時を指定して下さい。": "created_before must be later than created_after.",
+}
+
+func newCatalog() *catalog.Builder {
+	b := catalog.NewBuilder(catalog.Fallback(language.Japanese))
+	for key, msg := range english {
+		if err := b.SetString(language.English, key, msg); err != nil {
+			panic(err)
+		}
+	}
+	return b
+}
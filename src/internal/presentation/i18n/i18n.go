@@ -0,0 +1,74 @@
+package i18n
+
+import (
+	"errors"
+	"net/http"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// 対応している言語（先頭の日本語をデフォルトとする）
+var supported = []language.Tag{
+	language.Japanese,
+	language.English,
+}
+
+var matcher = language.NewMatcher(supported)
+
+// 書式と引数を持つメッセージ（文字数の上限などを含むエラーで実装する）
+type messageFormatter interface {
+	MessageFormat() (string, []any)
+}
+
+// 言語ごとにメッセージを翻訳する
+type Localizer struct {
+	tag     language.Tag
+	printer *message.Printer
+}
+
+func NewLocalizer(tag language.Tag) *Localizer {
+	return &Localizer{
+		tag:     tag,
+		printer: message.NewPrinter(tag, message.Catalog(messages)),
+	}
+}
+
+// リクエストヘッダーのAccept-Languageから言語を判定する（未対応の言語の場合は日本語）
+func FromRequest(r *http.Request) *Localizer {
+	tags, _, _ := language.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+	_, index, confidence := matcher.Match(tags...)
+
+	// 類似の言語による推測（例：frに対してen）は採用しない
+	if confidence <= language.Low {
+		index = 0
+	}
+
+	return NewLocalizer(supported[index])
+}
+
+// 言語のタグ（例：ja、en）
+func (l *Localizer) Tag() language.Tag {
+	return l.tag
+}
+
+// 日本語のメッセージをキーとして翻訳する（keyは書式として扱うため定数を渡す）
+func (l *Localizer) Sprintf(key string, args ...any) string {
+	return l.printer.Sprintf(key, args...)
+}
+
+// 書式を持たないメッセージを翻訳する（エラーメッセージなど、%を含む可能性がある値を渡す）
+// 翻訳が無い場合は「%s」で元のメッセージをそのまま返す
+func (l *Localizer) Message(key string) string {
+	return l.printer.Sprintf(message.Key(key, "%s"), key)
+}
+
+// エラーメッセージを翻訳する
+func (l *Localizer) Error(err error) string {
+	var f messageFormatter
+	if errors.As(err, &f) {
+		format, args := f.MessageFormat()
+		return l.printer.Sprintf(format, args...)
+	}
+	return l.Message(err.Error())
+}
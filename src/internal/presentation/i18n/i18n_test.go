@@ -0,0 +1,103 @@
+//go:build unit
+
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+// 書式と引数を持つテスト用のエラー
+type errMaxLength struct {
+	Max int
+}
+
+func (e *errMaxLength) Error() string {
+	format, args := e.MessageFormat()
+	return fmt.Sprintf(format, args...)
+}
+
+func (e *errMaxLength) MessageFormat() (string, []any) {
+	return "名前は%d文字以下にして下さい。", []any{e.Max}
+}
+
+func TestFromRequest(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		expected       language.Tag
+	}{
+		{"未指定の場合は日本語", "", language.Japanese},
+		{"日本語", "ja-JP", language.Japanese},
+		{"英語", "en-US,en;q=0.9", language.English},
+		{"優先度の高い言語", "fr;q=0.5,en;q=0.8,ja;q=0.9", language.Japanese},
+		{"未対応の言語の場合は日本語", "fr-FR", language.Japanese},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+
+			l := FromRequest(req)
+
+			assert.Equal(t, tt.expected, l.Tag())
+		})
+	}
+}
+
+func TestLocalizer_Error(t *testing.T) {
+	t.Run("英語に翻訳すること", func(t *testing.T) {
+		l := NewLocalizer(language.English)
+
+		assert.Equal(t, "The user was not found.", l.Error(fmt.Errorf("対象ユーザーが存在しません。")))
+		assert.Equal(t, "Name must be 50 characters or fewer.", l.Error(&errMaxLength{Max: 50}))
+	})
+
+	t.Run("ラップされたエラーの書式と引数を利用すること", func(t *testing.T) {
+		l := NewLocalizer(language.English)
+
+		err := fmt.Errorf("wrap: %w", &errMaxLength{Max: 10})
+
+		assert.Equal(t, "Name must be 10 characters or fewer.", l.Error(err))
+	})
+
+	t.Run("日本語の場合はそのまま返すこと", func(t *testing.T) {
+		l := NewLocalizer(language.Japanese)
+
+		assert.Equal(t, "名前は50文字以下にして下さい。", l.Error(&errMaxLength{Max: 50}))
+	})
+
+	t.Run("翻訳が無い場合は日本語のまま返すこと", func(t *testing.T) {
+		l := NewLocalizer(language.English)
+
+		assert.Equal(t, "未登録のメッセージ", l.Error(fmt.Errorf("未登録のメッセージ")))
+	})
+
+	t.Run("メッセージに%が含まれる場合も書式として解釈しないこと", func(t *testing.T) {
+		l := NewLocalizer(language.English)
+
+		assert.Equal(t, "進捗100%です。%d", l.Error(errors.New("進捗100%です。%d")))
+	})
+}
+
+func TestLocalizer_Message(t *testing.T) {
+	t.Run("英語に翻訳すること", func(t *testing.T) {
+		l := NewLocalizer(language.English)
+
+		assert.Equal(t, "The requested resource was not found.", l.Message("対象が存在しません。"))
+	})
+
+	t.Run("日本語の場合はそのまま返すこと", func(t *testing.T) {
+		l := NewLocalizer(language.Japanese)
+
+		assert.Equal(t, "対象が存在しません。", l.Message("対象が存在しません。"))
+	})
+}
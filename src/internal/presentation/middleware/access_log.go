@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go-gin-domain/internal/presentation/contextkey"
+
+	"github.com/gin-gonic/gin"
+)
+
+// アクセスログの設定
+type AccessLogConfig struct {
+	// 出力しないパス（例：/healthz）。ステータスが500以上の場合は出力する。
+	SkipPaths []string
+	// 処理時間がこの値以上の場合はWarnで出力する（0の場合は無効）
+	SlowThreshold time.Duration
+}
+
+// アクセスログ用（リクエストごとに1件、アプリケーションのログと同じ形式で出力する）
+func (m *Middleware) AccessLog() gin.HandlerFunc {
+	skipPaths := make(map[string]struct{}, len(m.accessLog.SkipPaths))
+	for _, path := range m.accessLog.SkipPaths {
+		skipPaths[path] = struct{}{}
+	}
+
+	return func(c *gin.Context) {
+		start := time.Now()
+		path := c.Request.URL.Path
+
+		c.Next()
+
+		status := c.Writer.Status()
+		if _, ok := skipPaths[path]; ok && status < http.StatusInternalServerError {
+			return
+		}
+
+		latency := time.Since(start)
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		// サイズが不明な場合は0とする
+		bytesIn := max(c.Request.ContentLength, 0)
+		bytesOut := max(c.Writer.Size(), 0)
+
+		args := []any{
+			"method", c.Request.Method,
+			"route", route,
+			"path", path,
+			"status", status,
+			"latencyMs", float64(latency.Microseconds()) / 1000,
+			"bytesIn", bytesIn,
+			"bytesOut", bytesOut,
+			"userAgent", c.Request.UserAgent(),
+			"clientIp", c.ClientIP(),
+		}
+		if len(c.Errors) > 0 {
+			args = append(args, "errors", c.Errors.Errors())
+		}
+
+		// リクエストID、X-Request-Source、UIDはハンドラーがコンテキストから付与する
+		// メッセージが全てのルートで共通のため、サンプリングの対象外とする（件数の調整はSkipPathsで行う）
+		ctx := context.WithValue(c.Request.Context(), contextkey.SkipSampling, true)
+		switch {
+		case status >= http.StatusInternalServerError:
+			m.logger.Error(ctx, "access", args...)
+		case m.accessLog.SlowThreshold > 0 && latency >= m.accessLog.SlowThreshold:
+			args = append(args, "slow", true)
+			m.logger.Warn(ctx, "access", args...)
+		default:
+			m.logger.Info(ctx, "access", args...)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+//go:build unit
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockLogger "go-gin-domain/internal/application/usecase/logger/mock_logger"
+	"go-gin-domain/internal/presentation/contextkey"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// ログの属性をマップに変換する
+func attrsToMap(t *testing.T, args []any) map[string]any {
+	t.Helper()
+	require.Equal(t, 0, len(args)%2)
+	m := make(map[string]any, len(args)/2)
+	for i := 0; i < len(args); i += 2 {
+		m[args[i].(string)] = args[i+1]
+	}
+	return m
+}
+
+func TestMiddleware_AccessLog(t *testing.T) {
+	// Ginのテストモードに設定
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// テスト用Ginの初期化処理
+	initTestGin := func(l *mockLogger.MockLogger) *gin.Engine {
+		m := NewMiddleware(nil, nil, nil, l, AccessLogConfig{
+			SkipPaths:     []string{"/healthz"},
+			SlowThreshold: 50 * time.Millisecond,
+		}, false)
+		r := gin.New()
+		r.Use(m.Request())
+		r.Use(m.AccessLog())
+		r.POST("/api/v1/user/:uid", func(c *gin.Context) {
+			c.String(http.StatusCreated, "created")
+		})
+		r.GET("/slow", func(c *gin.Context) {
+			time.Sleep(60 * time.Millisecond)
+			c.Status(http.StatusOK)
+		})
+		r.GET("/healthz", func(c *gin.Context) {
+			status := http.StatusOK
+			if c.Query("fail") != "" {
+				status = http.StatusServiceUnavailable
+			}
+			c.Status(status)
+		})
+		r.GET("/error", func(c *gin.Context) {
+			c.Status(http.StatusInternalServerError)
+		})
+		return r
+	}
+
+	t.Run("リクエストごとに1件の構造化ログを出力すること", func(t *testing.T) {
+		l := mockLogger.NewMockLogger(ctrl)
+		l.EXPECT().Info(gomock.Any(), "access", gomock.Any()).DoAndReturn(func(ctx context.Context, message string, args ...any) {
+			// リクエストIDはハンドラーがコンテキストから付与する
+			_, ok := ctx.Value(contextkey.RequestId).(string)
+			assert.True(t, ok)
+
+			// サンプリングの対象外とする
+			skipSampling, _ := ctx.Value(contextkey.SkipSampling).(bool)
+			assert.True(t, skipSampling)
+
+			attrs := attrsToMap(t, args)
+			assert.Equal(t, "POST", attrs["method"])
+			assert.Equal(t, "/api/v1/user/:uid", attrs["route"])
+			assert.Equal(t, "/api/v1/user/abc", attrs["path"])
+			assert.Equal(t, http.StatusCreated, attrs["status"])
+			assert.Equal(t, int64(4), attrs["bytesIn"])
+			assert.Equal(t, 7, attrs["bytesOut"])
+			assert.Equal(t, "test-agent", attrs["userAgent"])
+			assert.Equal(t, "192.0.2.1", attrs["clientIp"])
+		})
+		r := initTestGin(l)
+
+		req := httptest.NewRequest("POST", "/api/v1/user/abc", http.NoBody)
+		req.ContentLength = 4
+		req.Header.Set("User-Agent", "test-agent")
+		req.RemoteAddr = "192.0.2.1:12345"
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+	})
+
+	t.Run("処理時間が閾値以上の場合はWarnで出力すること", func(t *testing.T) {
+		l := mockLogger.NewMockLogger(ctrl)
+		l.EXPECT().Warn(gomock.Any(), "access", gomock.Any()).DoAndReturn(func(ctx context.Context, message string, args ...any) {
+			attrs := attrsToMap(t, args)
+			assert.Equal(t, true, attrs["slow"])
+		})
+		r := initTestGin(l)
+
+		req := httptest.NewRequest("GET", "/slow", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+	})
+
+	t.Run("ステータスが500以上の場合はErrorで出力すること", func(t *testing.T) {
+		l := mockLogger.NewMockLogger(ctrl)
+		l.EXPECT().Error(gomock.Any(), "access", gomock.Any())
+		r := initTestGin(l)
+
+		req := httptest.NewRequest("GET", "/error", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+	})
+
+	t.Run("除外するパスは出力しないこと（ステータスが500以上の場合は出力する）", func(t *testing.T) {
+		l := mockLogger.NewMockLogger(ctrl)
+		l.EXPECT().Error(gomock.Any(), "access", gomock.Any()).Times(1)
+		r := initTestGin(l)
+
+		req := httptest.NewRequest("GET", "/healthz", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		req = httptest.NewRequest("GET", "/healthz?fail=1", nil)
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+	})
+}
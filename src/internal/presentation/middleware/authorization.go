@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"slices"
+
+	"go-gin-domain/internal/application/usecase/authorization"
+	"go-gin-domain/internal/domain/apperror"
+	domain_user "go-gin-domain/internal/domain/user"
+	"go-gin-domain/internal/presentation/contextkey"
+	"go-gin-domain/internal/presentation/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 役割による認可用（Authの後に適用する）
+// 認証済みのユーザーの役割がrolesに含まれない場合はステータス403を返す
+func (m *Middleware) RequireRole(roles ...domain_user.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		actor, ok := m.actor(c)
+		if !ok {
+			return
+		}
+
+		if !slices.Contains(roles, actor.Role) {
+			m.logger.Warn(c.Request.Context(), "操作を拒否しました。", "actorUid", actor.UID, "role", actor.Role)
+			handler.WriteError(c, &apperror.ErrForbidden{Err: &authorization.ErrPermissionDenied{}})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// 権限による認可用（Authの後に適用する）
+// パスパラメータのuidを操作対象の所有者とし、許可されない場合はステータス403を返す
+func (m *Middleware) RequirePermission(permission authorization.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		actor, ok := m.actor(c)
+		if !ok {
+			return
+		}
+
+		if err := m.authorizer.Authorize(c.Request.Context(), actor, permission, c.Param("uid")); err != nil {
+			handler.WriteError(c, err)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// 認証済みのユーザーを操作者として取得し、共通コンテキストに設定する
+// （取得できない場合はエラーレスポンスを返して処理を中断する）
+func (m *Middleware) actor(c *gin.Context) (authorization.Actor, bool) {
+	ctx := c.Request.Context()
+
+	// 前の認可用のミドルウェアで設定済みの場合は再利用する
+	if actor, ok := authorization.ActorFromContext(ctx); ok {
+		return actor, true
+	}
+
+	uid, _ := ctx.Value(contextkey.UID).(string)
+	actor, err := m.authorizer.FindActor(ctx, uid)
+	if err != nil {
+		handler.WriteError(c, err)
+		c.Abort()
+		return authorization.Actor{}, false
+	}
+
+	// 共通コンテキストの設定
+	c.Request = c.Request.WithContext(authorization.WithActor(ctx, actor))
+
+	return actor, true
+}
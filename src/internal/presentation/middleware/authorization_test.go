@@ -0,0 +1,135 @@
+//go:build unit
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-gin-domain/internal/application/usecase/authorization"
+	mockAuthorization "go-gin-domain/internal/application/usecase/authorization/mock_authorization"
+	mockLogger "go-gin-domain/internal/application/usecase/logger/mock_logger"
+	"go-gin-domain/internal/domain/apperror"
+	domain_user "go-gin-domain/internal/domain/user"
+	"go-gin-domain/internal/presentation/contextkey"
+	"go-gin-domain/internal/presentation/problem"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// 認証済みのUIDを設定する（Authミドルウェアの代わり）
+func setTestUID(uid string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), contextkey.UID, uid))
+		c.Next()
+	}
+}
+
+// 共通コンテキストの操作者をレスポンスボディに返す
+func writeActor(c *gin.Context) {
+	actor, _ := authorization.ActorFromContext(c.Request.Context())
+	c.String(http.StatusOK, string(actor.Role))
+}
+
+func TestMiddleware_RequireRole(t *testing.T) {
+	// Ginのテストモードに設定
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAuthorizer := mockAuthorization.NewMockAuthorizer(ctrl)
+	mockLogger := mockLogger.NewMockLogger(ctrl)
+
+	m := NewMiddleware(nil, mockAuthorizer, nil, mockLogger, AccessLogConfig{}, false)
+	r := gin.New()
+	r.GET("/admin", setTestUID("xxxx-xxxx-xxxx-0001"), m.RequireRole(domain_user.RoleAdmin), writeActor)
+
+	t.Run("役割が含まれる場合は操作者を共通コンテキストに設定すること", func(t *testing.T) {
+		mockAuthorizer.EXPECT().FindActor(gomock.Any(), "xxxx-xxxx-xxxx-0001").Return(authorization.Actor{UID: "xxxx-xxxx-xxxx-0001", Role: domain_user.RoleAdmin}, nil)
+
+		req := httptest.NewRequest("GET", "/admin", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "admin", w.Body.String())
+	})
+
+	t.Run("役割が含まれない場合はステータス403を返すこと", func(t *testing.T) {
+		mockAuthorizer.EXPECT().FindActor(gomock.Any(), gomock.Any()).Return(authorization.Actor{UID: "xxxx-xxxx-xxxx-0001", Role: domain_user.RoleMember}, nil)
+		mockLogger.EXPECT().Warn(gomock.Any(), "操作を拒否しました。", "actorUid", "xxxx-xxxx-xxxx-0001", "role", domain_user.RoleMember)
+
+		req := httptest.NewRequest("GET", "/admin", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), problem.TypeForbidden)
+	})
+
+	t.Run("ユーザーが存在しない場合はステータス403を返すこと", func(t *testing.T) {
+		mockAuthorizer.EXPECT().FindActor(gomock.Any(), gomock.Any()).Return(authorization.Actor{}, &apperror.ErrForbidden{Err: &authorization.ErrActorNotFound{}})
+
+		req := httptest.NewRequest("GET", "/admin", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), "操作するユーザーが登録されていません。")
+	})
+
+	t.Run("ユーザーの取得に失敗した場合はステータス500を返すこと", func(t *testing.T) {
+		mockAuthorizer.EXPECT().FindActor(gomock.Any(), gomock.Any()).Return(authorization.Actor{}, errors.New("connection refused"))
+
+		req := httptest.NewRequest("GET", "/admin", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestMiddleware_RequirePermission(t *testing.T) {
+	// Ginのテストモードに設定
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAuthorizer := mockAuthorization.NewMockAuthorizer(ctrl)
+
+	member := authorization.Actor{UID: "xxxx-xxxx-xxxx-0002", Role: domain_user.RoleMember}
+
+	m := NewMiddleware(nil, mockAuthorizer, nil, nil, AccessLogConfig{}, false)
+	r := gin.New()
+	r.GET("/user/:uid", setTestUID(member.UID), m.RequirePermission(authorization.PermissionReadUser), writeActor)
+
+	t.Run("パスパラメータのuidを所有者として判定し、許可された場合は次の処理を実行すること", func(t *testing.T) {
+		mockAuthorizer.EXPECT().FindActor(gomock.Any(), member.UID).Return(member, nil)
+		mockAuthorizer.EXPECT().Authorize(gomock.Any(), member, authorization.PermissionReadUser, member.UID).Return(nil)
+
+		req := httptest.NewRequest("GET", "/user/"+member.UID, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "member", w.Body.String())
+	})
+
+	t.Run("許可されない場合はステータス403を返すこと", func(t *testing.T) {
+		mockAuthorizer.EXPECT().FindActor(gomock.Any(), member.UID).Return(member, nil)
+		mockAuthorizer.EXPECT().Authorize(gomock.Any(), member, authorization.PermissionReadUser, "xxxx-xxxx-xxxx-0001").
+			Return(&apperror.ErrForbidden{Err: &authorization.ErrPermissionDenied{}})
+
+		req := httptest.NewRequest("GET", "/user/xxxx-xxxx-xxxx-0001", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), "この操作は許可されていません。")
+	})
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ルーティングに一致しないリクエストのルート名（生のパスはラベルにしない）
+const unmatchedRoute = "unmatched"
+
+// HTTPリクエストのメトリクスを記録する
+type MetricsRecorder interface {
+	// 処理中のリクエスト数を増減する
+	IncInFlight(method, route string)
+	DecInFlight(method, route string)
+	// リクエストの処理結果と処理時間を記録する
+	ObserveRequest(method, route, status string, duration time.Duration)
+}
+
+// メトリクス用（ルートはパスのテンプレート（例：/api/v1/user/:uid）で集計する）
+func (m *Middleware) Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if m.metrics == nil {
+			c.Next()
+			return
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		method := c.Request.Method
+
+		m.metrics.IncInFlight(method, route)
+		defer m.metrics.DecInFlight(method, route)
+
+		start := time.Now()
+		c.Next()
+
+		m.metrics.ObserveRequest(method, route, strconv.Itoa(c.Writer.Status()), time.Since(start))
+	}
+}
@@ -0,0 +1,86 @@
+//go:build unit
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// テスト用のメトリクスの記録先
+type fakeMetricsRecorder struct {
+	mu       sync.Mutex
+	inFlight map[string]int
+	requests []string
+}
+
+func (r *fakeMetricsRecorder) IncInFlight(method, route string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.inFlight[method+" "+route]++
+}
+
+func (r *fakeMetricsRecorder) DecInFlight(method, route string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.inFlight[method+" "+route]--
+}
+
+func (r *fakeMetricsRecorder) ObserveRequest(method, route, status string, duration time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, method+" "+route+" "+status)
+}
+
+func TestMiddleware_Metrics(t *testing.T) {
+	// Ginのテストモードに設定
+	gin.SetMode(gin.TestMode)
+
+	t.Run("パスのテンプレート、メソッド、ステータスで記録すること", func(t *testing.T) {
+		recorder := &fakeMetricsRecorder{inFlight: map[string]int{}}
+		m := NewMiddleware(nil, nil, recorder, nil, AccessLogConfig{}, false)
+
+		r := gin.New()
+		r.Use(m.Metrics())
+		r.GET("/api/v1/user/:uid", func(c *gin.Context) {
+			// 処理中のリクエスト数に含まれること
+			assert.Equal(t, 1, recorder.inFlight["GET /api/v1/user/:uid"])
+			c.Status(http.StatusNotFound)
+		})
+
+		for _, path := range []string{"/api/v1/user/abc", "/api/v1/user/xyz", "/unknown"} {
+			req := httptest.NewRequest("GET", path, nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+		}
+
+		assert.Equal(t, []string{
+			"GET /api/v1/user/:uid 404",
+			"GET /api/v1/user/:uid 404",
+			"GET unmatched 404",
+		}, recorder.requests)
+		assert.Equal(t, 0, recorder.inFlight["GET /api/v1/user/:uid"])
+	})
+
+	t.Run("記録先がnilの場合は記録しないこと", func(t *testing.T) {
+		m := NewMiddleware(nil, nil, nil, nil, AccessLogConfig{}, false)
+
+		r := gin.New()
+		r.Use(m.Metrics())
+		r.GET("/healthz", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		req := httptest.NewRequest("GET", "/healthz", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -2,88 +2,138 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
+	"go-gin-domain/internal/application/usecase/auth"
+	"go-gin-domain/internal/application/usecase/authorization"
+	logger_usecase "go-gin-domain/internal/application/usecase/logger"
+	"go-gin-domain/internal/presentation/contextkey"
+	"go-gin-domain/internal/presentation/i18n"
+	"go-gin-domain/internal/presentation/problem"
+
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
-type contextKey string
-
-const (
-	RequestId      contextKey = "Request-Id"
-	XRequestSource contextKey = "X-Request-Source"
-	UID            contextKey = "UID"
-)
+var tracer = otel.Tracer("go-gin-domain/internal/presentation/middleware")
 
-type Middleware struct{}
+type Middleware struct {
+	tokenVerifier auth.TokenVerifier
+	authorizer    authorization.Authorizer
+	metrics       MetricsRecorder
+	logger        logger_usecase.Logger
+	accessLog     AccessLogConfig
+	// リクエストヘッダーのX-Request-Idを引き継ぐか
+	trustRequestId bool
+}
 
-func NewMiddleware() *Middleware {
-	return &Middleware{}
+// metricsがnilの場合はメトリクスを記録しない
+// trustRequestIdがtrueの場合、リクエストヘッダーのX-Request-Id（形式が正しいもののみ）をリクエストIDとして使用する
+func NewMiddleware(tokenVerifier auth.TokenVerifier, authorizer authorization.Authorizer, metrics MetricsRecorder, logger logger_usecase.Logger, accessLog AccessLogConfig, trustRequestId bool) *Middleware {
+	return &Middleware{
+		tokenVerifier:  tokenVerifier,
+		authorizer:     authorizer,
+		metrics:        metrics,
+		logger:         logger,
+		accessLog:      accessLog,
+		trustRequestId: trustRequestId,
+	}
 }
 
 // リクエスト用
 func (m *Middleware) Request() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// 一意のIDを取得
-		uuid := uuid.New().String()
+		// リクエストIDを取得し、レスポンスヘッダーにも設定する
+		requestId := m.requestId(c)
+		c.Header(contextkey.HeaderRequestId, requestId)
 
 		// 共通コンテキストにX-Request-Idを設定
 		ctx := c.Request.Context()
-		ctx = context.WithValue(ctx, RequestId, uuid)
+		ctx = context.WithValue(ctx, contextkey.RequestId, requestId)
 
 		// リクエストヘッダーからX-Request-Sourceを取得
-		xRequestSource := c.GetHeader(string(XRequestSource))
+		xRequestSource := c.GetHeader(string(contextkey.XRequestSource))
 		if xRequestSource == "" {
 			xRequestSource = "-"
 		}
 
 		// 共通コンテキストにX-Request-Sourceを設定
-		ctx = context.WithValue(ctx, XRequestSource, xRequestSource)
+		ctx = context.WithValue(ctx, contextkey.XRequestSource, xRequestSource)
+
+		// リクエストヘッダーのtraceparentからトレースを引き継ぎ、リクエスト単位のスパンを開始
+		ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(c.Request.Header))
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		ctx, span := tracer.Start(ctx, fmt.Sprintf("%s %s", c.Request.Method, route),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(c.Request.Method),
+				semconv.HTTPRoute(route),
+				semconv.URLPath(c.Request.URL.Path),
+			),
+		)
+		defer span.End()
 
 		// 共通コンテキストの設定
 		c.Request = c.Request.WithContext(ctx)
 
 		c.Next()
+
+		// レスポンスのステータスとハンドラーで発生したエラーをスパンに記録
+		status := c.Writer.Status()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		for _, e := range c.Errors {
+			span.RecordError(e.Err)
+		}
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
 	}
 }
 
-// カスタムロガー
-func (m *Middleware) CustomLogger() gin.HandlerFunc {
-	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
-		// Request-Idの取得
-		requestID, ok := param.Request.Context().Value(RequestId).(string)
-		if !ok {
-			requestID = "-"
-		}
+// リクエストIDの最大文字数
+const maxRequestIdLength = 128
 
-		// カラーの取得
-		var statusColor, methodColor, resetColor string
-		if param.IsOutputColor() {
-			statusColor = param.StatusCodeColor()
-			methodColor = param.MethodColor()
-			resetColor = param.ResetColor()
+// リクエストIDを取得する
+// 信頼する設定の場合はリクエストヘッダーの値を引き継ぎ、それ以外は時刻順に並ぶUUIDv7を生成する
+func (m *Middleware) requestId(c *gin.Context) string {
+	if m.trustRequestId {
+		if id := c.GetHeader(contextkey.HeaderRequestId); validRequestId(id) {
+			return id
 		}
+	}
 
-		// ログのフォーマットを定義
-		// [GIN] 2006/01/02 - 15:04:05 | {Request-Id} | 200 | 1.2345ms | 127.0.0.1 | GET /path
-		return fmt.Sprintf("[GIN] %v| %s |%s %3d %s| %13v | %15s |%s %-7s %s %#v\n%s",
-			param.TimeStamp.Format("2006/01/02 - 15:04:05"),
-			requestID,
-			statusColor,
-			param.StatusCode,
-			resetColor,
-			param.Latency,
-			param.ClientIP,
-			methodColor,
-			param.Method,
-			resetColor,
-			param.Path,
-			param.ErrorMessage,
-		)
-	})
+	id, err := uuid.NewV7()
+	if err != nil {
+		return uuid.New().String()
+	}
+	return id.String()
+}
+
+// ログやヘッダーに出力しても安全な値か（英数字と「-_.:」のみ、128文字以内）
+func validRequestId(id string) bool {
+	if id == "" || len(id) > maxRequestIdLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
 }
 
 // 認証用
@@ -92,26 +142,42 @@ func (m *Middleware) Auth() gin.HandlerFunc {
 		// Bearerトークン取得
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"message": "認証用トークンが設定されていません。",
-			})
+			abortUnauthorized(c, "", "認証用トークンが設定されていません。")
+			return
+		}
+		scheme, token, found := strings.Cut(authHeader, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") {
+			abortUnauthorized(c, "invalid_request", "認証用トークンの形式が不正です。")
 			return
 		}
-		token := strings.TrimPrefix(authHeader, "Bearer ")
+		token = strings.TrimSpace(token)
 		if token == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"message": "認証用トークンが設定されていません。",
-			})
+			abortUnauthorized(c, "", "認証用トークンが設定されていません。")
 			return
 		}
 
-		// TODO: 認証チェックを追加する
-		// TODO: 認証済みならuidを取得
-		uid := "-"
+		// 認証チェック（署名、exp/nbf/iss/audを検証）
+		claims, err := m.tokenVerifier.Verify(c.Request.Context(), token)
+		if err != nil {
+			var errTokenMalformed *auth.ErrTokenMalformed
+			var errTokenExpired *auth.ErrTokenExpired
+			switch {
+			case errors.As(err, &errTokenMalformed):
+				abortUnauthorized(c, "invalid_token", errTokenMalformed.Error())
+			case errors.As(err, &errTokenExpired):
+				abortUnauthorized(c, "invalid_token", errTokenExpired.Error())
+			default:
+				abortUnauthorized(c, "invalid_token", (&auth.ErrTokenInvalid{}).Error())
+			}
+			return
+		}
+
+		// 認証済みならuidを取得
+		uid := claims.Subject
 
 		// 共通コンテキストにuidを設定
 		ctx := c.Request.Context()
-		ctx = context.WithValue(ctx, UID, uid)
+		ctx = context.WithValue(ctx, contextkey.UID, uid)
 
 		// 共通コンテキストの設定
 		c.Request = c.Request.WithContext(ctx)
@@ -119,3 +185,16 @@ func (m *Middleware) Auth() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// 認証エラー（RFC 6750のWWW-Authenticateヘッダーを付与）
+func abortUnauthorized(c *gin.Context, errorCode, message string) {
+	challenge := "Bearer"
+	if errorCode != "" {
+		challenge = fmt.Sprintf(`Bearer error="%s"`, errorCode)
+	}
+	c.Header("WWW-Authenticate", challenge)
+
+	// メッセージはリクエストの言語に翻訳する
+	l := i18n.FromRequest(c.Request)
+	problem.Write(c, problem.New(l, problem.TypeUnauthorized, l.Message(message)))
+}
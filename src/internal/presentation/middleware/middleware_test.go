@@ -0,0 +1,134 @@
+//go:build unit
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go-gin-domain/internal/presentation/contextkey"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMiddleware_Request(t *testing.T) {
+	// Ginのテストモードに設定
+	gin.SetMode(gin.TestMode)
+
+	// 終了したスパンを記録する
+	spanRecorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	m := NewMiddleware(nil, nil, nil, nil, AccessLogConfig{}, false)
+	r := gin.New()
+	r.Use(m.Request())
+	r.GET("/api/v1/user/:uid", func(c *gin.Context) {
+		// ハンドラーのコンテキストにスパンが設定されていること
+		assert.True(t, trace.SpanContextFromContext(c.Request.Context()).IsValid())
+		c.Status(http.StatusInternalServerError)
+	})
+
+	t.Run("traceparentのトレースを引き継ぎ、ルートのテンプレートでスパンを記録すること", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/user/abc", nil)
+		req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		spans := spanRecorder.Ended()
+		require.Len(t, spans, 1)
+		span := spans[0]
+		assert.Equal(t, "GET /api/v1/user/:uid", span.Name())
+		assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+		assert.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+		assert.Equal(t, codes.Error, span.Status().Code)
+	})
+}
+
+func TestMiddleware_Request_RequestId(t *testing.T) {
+	// Ginのテストモードに設定
+	gin.SetMode(gin.TestMode)
+
+	// テスト用Ginの初期化処理（共通コンテキストのリクエストIDをレスポンスボディに返す）
+	initTestGin := func(trustRequestId bool) *gin.Engine {
+		m := NewMiddleware(nil, nil, nil, nil, AccessLogConfig{}, trustRequestId)
+		r := gin.New()
+		r.Use(m.Request())
+		r.GET("/", func(c *gin.Context) {
+			requestId, _ := c.Request.Context().Value(contextkey.RequestId).(string)
+			c.String(http.StatusOK, requestId)
+		})
+		return r
+	}
+
+	t.Run("リクエストヘッダーのX-Request-Idを引き継ぎ、レスポンスヘッダーに設定すること", func(t *testing.T) {
+		r := initTestGin(true)
+
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Header.Set("X-Request-Id", "gateway-req.0001:abc_DEF")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, "gateway-req.0001:abc_DEF", w.Body.String())
+		assert.Equal(t, "gateway-req.0001:abc_DEF", w.Header().Get("X-Request-Id"))
+	})
+
+	t.Run("X-Request-Idが無い場合はUUIDv7を生成すること", func(t *testing.T) {
+		r := initTestGin(true)
+
+		req := httptest.NewRequest("GET", "/", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		id, err := uuid.Parse(w.Body.String())
+		require.NoError(t, err)
+		assert.Equal(t, uuid.Version(7), id.Version())
+		assert.Equal(t, w.Body.String(), w.Header().Get("X-Request-Id"))
+	})
+
+	t.Run("X-Request-Idの形式が不正な場合は生成した値を使用すること", func(t *testing.T) {
+		r := initTestGin(true)
+
+		for _, value := range []string{
+			"invalid id",
+			"invalid\x00id",
+			"<script>",
+			strings.Repeat("a", 129),
+		} {
+			req := httptest.NewRequest("GET", "/", nil)
+			req.Header.Set("X-Request-Id", value)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			_, err := uuid.Parse(w.Body.String())
+			assert.NoError(t, err, value)
+			assert.Equal(t, w.Body.String(), w.Header().Get("X-Request-Id"))
+		}
+	})
+
+	t.Run("信頼しない設定の場合はX-Request-Idを引き継がないこと", func(t *testing.T) {
+		r := initTestGin(false)
+
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Header.Set("X-Request-Id", "gateway-req-0001")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.NotEqual(t, "gateway-req-0001", w.Body.String())
+		_, err := uuid.Parse(w.Body.String())
+		assert.NoError(t, err)
+		assert.Equal(t, w.Body.String(), w.Header().Get("X-Request-Id"))
+	})
+}
@@ -0,0 +1,98 @@
+package problem
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go-gin-domain/internal/presentation/contextkey"
+	"go-gin-domain/internal/presentation/i18n"
+
+	"github.com/gin-gonic/gin"
+)
+
+// エラーレスポンスのContent-Type（RFC 7807）
+const ContentType = "application/problem+json"
+
+// エラーの種類を表すURI（RFC 7807のtype）
+const (
+	TypeBadRequest   = "/problems/bad-request"
+	TypeValidation   = "/problems/validation-error"
+	TypeUnauthorized = "/problems/unauthorized"
+	TypeForbidden    = "/problems/forbidden"
+	TypeNotFound     = "/problems/not-found"
+	TypeConflict     = "/problems/conflict"
+	TypeInternal     = "/problems/internal-server-error"
+)
+
+// エラーレスポンス用の構造体を定義（RFC 7807）
+type Problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	// リクエストID（ログとの突き合わせ用）
+	Instance string `json:"instance,omitempty"`
+	// 項目ごとのエラー（バリデーションエラーの場合のみ）
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// エラーの種類ごとのステータスとタイトル
+var definitions = map[string]struct {
+	status int
+	title  string
+}{
+	TypeBadRequest:   {http.StatusBadRequest, "リクエストが不正です。"},
+	TypeValidation:   {http.StatusUnprocessableEntity, "入力値が不正です。"},
+	TypeUnauthorized: {http.StatusUnauthorized, "認証に失敗しました。"},
+	TypeForbidden:    {http.StatusForbidden, "操作する権限がありません。"},
+	TypeNotFound:     {http.StatusNotFound, "対象が存在しません。"},
+	TypeConflict:     {http.StatusConflict, "既存のデータと競合しています。"},
+	TypeInternal:     {http.StatusInternalServerError, "サーバー内部でエラーが発生しました。"},
+}
+
+// エラーの種類からProblemを作成する（タイトルはリクエストの言語に翻訳する）
+func New(l *i18n.Localizer, problemType, detail string) *Problem {
+	def, ok := definitions[problemType]
+	if !ok {
+		problemType = TypeInternal
+		def = definitions[TypeInternal]
+	}
+
+	return &Problem{
+		Type:   problemType,
+		Title:  l.Message(def.title),
+		Status: def.status,
+		Detail: detail,
+	}
+}
+
+// エラーレスポンスを返して後続の処理を中断する
+func Write(c *gin.Context, p *Problem) {
+	// instanceにはリクエストIDを設定する
+	if requestID, ok := c.Request.Context().Value(contextkey.RequestId).(string); ok {
+		p.Instance = requestID
+	}
+
+	// Content-Typeを先に設定し、GinのJSONレンダラーで上書きされないようにする
+	c.Header("Content-Type", ContentType)
+	c.AbortWithStatusJSON(p.Status, p)
+}
+
+// 項目ごとのエラーからバリデーションエラーのProblemを作成する
+func NewValidation(l *i18n.Localizer, errors []FieldError) *Problem {
+	msgs := make([]string, 0, len(errors))
+	for _, e := range errors {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", e.Field, e.Message))
+	}
+
+	p := New(l, TypeValidation, strings.Join(msgs, ", "))
+	p.Errors = errors
+
+	return p
+}
@@ -1,6 +1,10 @@
 package router
 
 import (
+	"net/http"
+
+	"go-gin-domain/internal/application/usecase/authorization"
+	domain_user "go-gin-domain/internal/domain/user"
 	"go-gin-domain/internal/presentation/middleware"
 	"go-gin-domain/internal/registry"
 
@@ -12,20 +16,39 @@ func SetupRouter(c *registry.Controller, m *middleware.Middleware) *gin.Engine {
 
 	// 共通ミドルウェアの適用
 	r.Use(m.Request())
-	r.Use(m.CustomLogger())
+	r.Use(m.Metrics())
+	r.Use(m.AccessLog())
 	r.Use(gin.Recovery())
 
+	// ヘルスチェック（認証不要）
+	r.GET("/healthz", c.Health.Liveness)
+	r.GET("/readyz", c.Health.Readiness)
+
 	// ルーティングの設定
+	// （memberは自分自身のみ操作でき、adminは全てのユーザーを管理できる）
 	apiV1 := r.Group("/api/v1")
 	apiV1.POST("/user", c.User.Create)
-	apiV1.GET("/users", m.Auth(), c.User.FindAll)
-	apiV1.GET("/user/:uid", m.Auth(), c.User.FindByUID)
-	apiV1.PUT("/user/:uid", m.Auth(), c.User.Update)
-	apiV1.DELETE("/user/:uid", m.Auth(), c.User.Delete)
+	apiV1.GET("/users", m.Auth(), m.RequirePermission(authorization.PermissionListUsers), c.User.FindAll)
+	apiV1.GET("/user/:uid", m.Auth(), m.RequirePermission(authorization.PermissionReadUser), c.User.FindByUID)
+	apiV1.PUT("/user/:uid", m.Auth(), m.RequirePermission(authorization.PermissionUpdateUser), c.User.Update)
+	apiV1.DELETE("/user/:uid", m.Auth(), m.RequirePermission(authorization.PermissionDeleteUser), c.User.Delete)
 
-	// Post用追加
-	apiV1.POST("/post", c.Post.Create)
+	// Post用追加（更新と削除の可否は投稿者と役割からユースケースで判定する）
+	apiV1.POST("/post", m.Auth(), m.RequirePermission(authorization.PermissionCreatePost), c.Post.Create)
 	apiV1.GET("/posts", c.Post.FindAll)
+	apiV1.GET("/post/:id", c.Post.FindByID)
+	apiV1.PUT("/post/:id", m.Auth(), m.RequireRole(domain_user.RoleAdmin, domain_user.RoleMember), c.Post.Update)
+	apiV1.DELETE("/post/:id", m.Auth(), m.RequireRole(domain_user.RoleAdmin, domain_user.RoleMember), c.Post.Delete)
+
+	return r
+}
+
+// 管理用ポートのルーティング（メトリクスなど、外部に公開しないエンドポイント）
+func SetupAdminRouter(metrics http.Handler) *gin.Engine {
+	r := gin.New()
+	r.Use(gin.Recovery())
+
+	r.GET("/metrics", gin.WrapH(metrics))
 
 	return r
 }
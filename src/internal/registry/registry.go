@@ -2,53 +2,263 @@ package registry
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"os"
 
+	"go-gin-domain/internal/application/usecase/authorization"
 	usecase_post "go-gin-domain/internal/application/usecase/post"
+	"go-gin-domain/internal/application/usecase/transaction"
 	usecase_user "go-gin-domain/internal/application/usecase/user"
+	"go-gin-domain/internal/config"
+	domain_post "go-gin-domain/internal/domain/post"
+	"go-gin-domain/internal/domain/repository"
+	domain_user "go-gin-domain/internal/domain/user"
+	infra_auth "go-gin-domain/internal/infrastructure/auth"
 	"go-gin-domain/internal/infrastructure/database"
+	"go-gin-domain/internal/infrastructure/database/migrations"
 	"go-gin-domain/internal/infrastructure/logger"
+	infra_metrics "go-gin-domain/internal/infrastructure/metrics"
 	persistence_post "go-gin-domain/internal/infrastructure/persistence/post"
 	persistence_user "go-gin-domain/internal/infrastructure/persistence/user"
+	"go-gin-domain/internal/infrastructure/tracing"
+	handler_health "go-gin-domain/internal/presentation/handler/health"
 	handler_post "go-gin-domain/internal/presentation/handler/post"
 	handler_user "go-gin-domain/internal/presentation/handler/user"
+	"go-gin-domain/internal/presentation/middleware"
 )
 
 // ハンドラーをまとめるコントローラー構造体
 type Controller struct {
-	User handler_user.UserHandler
-	Post handler_post.PostHandler
+	User   handler_user.UserHandler
+	Post   handler_post.PostHandler
+	Health handler_health.HealthHandler
+	// 認可用のミドルウェアで利用する
+	Authorizer authorization.Authorizer
 }
 
-func NewController() *Controller {
+// 終了時に戻り値の関数を呼び出し、DB接続などのリソースを解放する（エラーの場合は解放済み）
+// logHandlerはNewSlogHandlerで作成し、全てのロガーで共有する（サンプリングの件数を共有するため）
+func NewController(cfg *config.Config, logHandler slog.Handler, metrics *infra_metrics.PrometheusMetrics) (*Controller, func() error, error) {
 	// コンテキスト
 	ctx := context.Background()
 
+	// 終了時に解放するリソース
+	var closers []func() error
+
 	// ロガー設定
-	logger := logger.NewSlogLogger()
+	logger := logger.NewSlogLogger(logHandler)
 
-	// DB設定（今回はダミー設定とする）
-	cfg := database.DummyConfig{
-		Dummy: "dummy",
-	}
-	db_dummy, err := database.NewDummyConnection(cfg, logger)
-	if err != nil {
-		msg := fmt.Sprintf("エラー: %s", err.Error())
-		logger.Error(ctx, msg)
+	// リポジトリの設定（設定「REPOSITORY」で切り替え）
+	var db repository.DB
+	var txManager transaction.TxManager
+	var userRepo domain_user.UserRepository
+	var postRepo domain_post.PostRepository
+	// レディネスの判定で確認する依存先
+	var checks []handler_health.Check
+	switch cfg.Repository {
+	case config.RepositoryMemory:
+		// インメモリ（ローカル開発およびテスト用）
+		txManager = database.NewMemoryTxManager()
+		userRepo = persistence_user.NewMemoryUserRepository(logger)
+		postRepo = persistence_post.NewMemoryPostRepository(logger)
+
+		// adminのユーザーを登録（設定「MEMORY_ADMIN_UID」「MEMORY_ADMIN_EMAIL」）
+		if cfg.Memory.AdminUID != "" {
+			if err := seedMemoryAdmin(ctx, userRepo, cfg.Memory); err != nil {
+				return nil, nil, fmt.Errorf("adminのユーザーの登録に失敗しました。: %w", err)
+			}
+		}
+	default:
+		// DB設定
+		sqlDB, err := database.NewPostgresConnection(newPostgresConfig(cfg), logger)
+		if err != nil {
+			if sqlDB != nil {
+				sqlDB.Close()
+			}
+			return nil, nil, err
+		}
+
+		// 起動時のマイグレーション（複数インスタンスの同時起動はロックで直列化される）
+		if cfg.DB.MigrateOnStart {
+			migrator, err := database.NewMigrator(sqlDB, migrations.FS, logger)
+			if err == nil {
+				_, err = migrator.Up(ctx)
+			}
+			if err != nil {
+				logger.Error(ctx, "起動時のマイグレーションに失敗しました。", "error", err)
+			}
+		}
+
+		closers = append(closers, sqlDB.Close)
+		checks = append(checks, handler_health.Check{Name: "database", Check: sqlDB.PingContext})
+
+		db = sqlDB
+		txManager = database.NewSQLTxManager(sqlDB)
+		userRepo = persistence_user.NewUserRepository(logger)
+		postRepo = persistence_post.NewPostRepository(logger)
 	}
 
+	// リポジトリの呼び出しをトレースのスパンとして記録
+	userRepo = persistence_user.NewTracingUserRepository(userRepo)
+	postRepo = persistence_post.NewTracingPostRepository(postRepo)
+
+	// 認可の設定（役割ごとの権限）
+	authorizer := authorization.NewAuthorizer(db, userRepo, authorization.DefaultPolicy(), logger)
+
 	// userドメインのハンドラー設定
-	userRepo := persistence_user.NewUserRepository(logger)
-	userUsecase := usecase_user.NewUserUsecase(db_dummy, userRepo, logger)
+	userUsecase := usecase_user.NewUserUsecase(db, txManager, userRepo, logger)
+	userUsecase = usecase_user.NewTracingUserUsecase(userUsecase)
+	userUsecase = usecase_user.NewMetricsUserUsecase(userUsecase, metrics)
 	userHandler := handler_user.NewUserHandler(userUsecase)
 
+	// Postの本文のチェック条件（設定「POST_TEXT_MAX_LENGTH」で最大文字数を変更可能）
+	textRule := domain_post.TextRule{MaxLength: cfg.Post.TextMaxLength}
+
 	// postドメインのハンドラー設定
-	postRepo := persistence_post.NewPostRepository(logger)
-	postUsecase := usecase_post.NewPostUsecase(db_dummy, postRepo, logger)
+	postUsecase := usecase_post.NewPostUsecase(db, txManager, postRepo, textRule, authorizer, logger)
+	postUsecase = usecase_post.NewTracingPostUsecase(postUsecase)
+	postUsecase = usecase_post.NewMetricsPostUsecase(postUsecase, metrics)
 	postHandler := handler_post.NewPostHandler(postUsecase)
 
+	// ヘルスチェックのハンドラー設定
+	healthHandler := handler_health.NewHealthHandler(logger, checks...)
+
+	// リソースを作成した順とは逆順に解放する
+	cleanup := func() error {
+		var errs []error
+		for i := len(closers) - 1; i >= 0; i-- {
+			errs = append(errs, closers[i]())
+		}
+		return errors.Join(errs...)
+	}
+
 	return &Controller{
-		User: userHandler,
-		Post: postHandler,
+		User:       userHandler,
+		Post:       postHandler,
+		Health:     healthHandler,
+		Authorizer: authorizer,
+	}, cleanup, nil
+}
+
+// メトリクス（HTTPリクエストとユースケースの呼び出し結果を記録し、管理用ポートで公開する）
+func NewMetrics() *infra_metrics.PrometheusMetrics {
+	return infra_metrics.NewPrometheusMetrics()
+}
+
+// ログの設定（アプリケーションで1つだけ作成し、各ロガーに渡す）
+func NewSlogHandler(cfg *config.Config) *logger.SlogHandler {
+	var level slog.Level
+	_ = level.UnmarshalText([]byte(cfg.Log.Level))
+
+	var output io.Writer
+	switch cfg.Log.Output {
+	case "stderr":
+		output = os.Stderr
+	case "discard":
+		output = io.Discard
+	default:
+		output = os.Stdout
+	}
+
+	var sampling *logger.SamplingConfig
+	if cfg.Log.SamplingInitial > 0 {
+		sampling = &logger.SamplingConfig{
+			Tick:       cfg.Log.SamplingTick,
+			Initial:    cfg.Log.SamplingInitial,
+			Thereafter: cfg.Log.SamplingThereafter,
+		}
+	}
+
+	return logger.NewSlogHandler(logger.Config{
+		Format:     cfg.Log.Format,
+		Level:      level,
+		Output:     output,
+		AddSource:  cfg.Log.AddSource,
+		RedactKeys: cfg.Log.RedactKeys,
+		Sampling:   sampling,
+	})
+}
+
+// トレース（OpenTelemetry）の設定。終了時に戻り値の関数を呼び出し、未送信のスパンを送信する
+func NewTracing(ctx context.Context, cfg *config.Config) (func(context.Context) error, error) {
+	return tracing.NewTracerProvider(ctx, tracing.Config{
+		ServiceName: cfg.Tracing.ServiceName,
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.Endpoint,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+}
+
+func NewMigrator(cfg *config.Config, logHandler slog.Handler) (*database.Migrator, func() error, error) {
+	// ロガー設定
+	logger := logger.NewSlogLogger(logHandler)
+
+	// DB設定
+	db, err := database.NewPostgresConnection(newPostgresConfig(cfg), logger)
+	if err != nil {
+		if db != nil {
+			db.Close()
+		}
+		return nil, nil, err
+	}
+
+	migrator, err := database.NewMigrator(db, migrations.FS, logger)
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+
+	return migrator, db.Close, nil
+}
+
+func NewMiddleware(cfg *config.Config, logHandler slog.Handler, metrics *infra_metrics.PrometheusMetrics, authorizer authorization.Authorizer) (*middleware.Middleware, error) {
+	// JWT検証の設定
+	jwtConfig := infra_auth.JWTConfig{
+		HMACSecret: cfg.JWT.HMACSecret.Value(),
+		JWKSFile:   cfg.JWT.JWKSFile,
+		JWKSURL:    cfg.JWT.JWKSURL,
+		Issuer:     cfg.JWT.Issuer,
+		Audience:   cfg.JWT.Audience,
+		Leeway:     cfg.JWT.Leeway,
+	}
+	tokenVerifier, err := infra_auth.NewJWTVerifier(jwtConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// アクセスログの設定
+	logger := logger.NewSlogLogger(logHandler)
+	accessLog := middleware.AccessLogConfig{
+		SkipPaths:     cfg.Log.AccessLogSkipPaths,
+		SlowThreshold: cfg.Log.SlowRequestThreshold,
+	}
+
+	return middleware.NewMiddleware(tokenVerifier, authorizer, metrics, logger, accessLog, cfg.Server.TrustRequestId), nil
+}
+
+// インメモリのリポジトリにadminのユーザーを登録する（ローカル開発およびテスト用）
+func seedMemoryAdmin(ctx context.Context, userRepo domain_user.UserRepository, cfg config.MemoryConfig) error {
+	admin, err := domain_user.NewUser(cfg.AdminUID, "管理者", "ユーザー", cfg.AdminEmail)
+	if err != nil {
+		return err
+	}
+	admin.Role = domain_user.RoleAdmin
+
+	_, err = userRepo.Create(ctx, nil, admin)
+	return err
+}
+
+// 設定からDB設定を取得する
+func newPostgresConfig(cfg *config.Config) database.PostgresConfig {
+	return database.PostgresConfig{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password.Value(),
+		DBName:   cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
 	}
 }
@@ -1,38 +1,94 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"os"
 
-	"go-gin-domain/internal/presentation/middleware"
+	"go-gin-domain/internal/config"
 	"go-gin-domain/internal/presentation/router"
 	"go-gin-domain/internal/registry"
-
-	"github.com/joho/godotenv"
 )
 
 func main() {
-	// .env ファイルの読み込み
-	err := godotenv.Load()
+	// 設定の読み込み（環境変数、.envファイル、YAMLファイル）
+	cfg, err := config.Load()
 	if err != nil {
-		slog.Error(".envファイルの読み込みに失敗しました。")
+		slog.Error(fmt.Sprintf("設定の読み込みに失敗しました。: %s", err.Error()))
+		os.Exit(1)
 	}
 
-	// ポート番号の設定
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
+	// ログ設定（形式、レベル、出力先など）
+	// ハンドラーは1つだけ作成し、サンプリングの件数をアプリケーション全体で共有する
+	logHandler := registry.NewSlogHandler(cfg)
+	slog.SetDefault(slog.New(logHandler))
+
+	// サブコマンドの実行（例：go run . migrate up）
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		// DB接続の設定のチェック（サーバー用の設定は不要のため、DBの項目のみ）
+		if err := cfg.DB.Validate(); err != nil {
+			slog.Error(fmt.Sprintf("設定が不正です。: %s", err.Error()))
+			os.Exit(1)
+		}
+		if err := runMigrate(cfg, logHandler, os.Args[2:]); err != nil {
+			slog.Error(fmt.Sprintf("マイグレーションに失敗しました。: %s", err.Error()))
+			os.Exit(1)
+		}
+		return
 	}
-	startPort := fmt.Sprintf(":%s", port)
 
-	// サーバー起動ログ出力
-	env := os.Getenv("ENV")
-	slog.Info(fmt.Sprintf("[ENV=%s] Start Gin Server Port: %s", env, port))
+	// 設定のチェック（不正な場合は起動しない）
+	if err := cfg.Validate(); err != nil {
+		slog.Error(fmt.Sprintf("設定が不正です。: %s", err.Error()))
+		os.Exit(1)
+	}
+
+	// サーバー起動ログ出力（秘匿情報はマスクされる）
+	slog.Info(fmt.Sprintf("[ENV=%s] Start Gin Server Port: %s, Admin Port: %s", cfg.Env, cfg.Server.Port, cfg.Server.AdminPort))
+	slog.Info(fmt.Sprintf("Config: %s", cfg))
+
+	// トレース設定
+	shutdownTracing, err := registry.NewTracing(context.Background(), cfg)
+	if err != nil {
+		slog.Error(fmt.Sprintf("トレースの設定に失敗しました。: %s", err.Error()))
+		os.Exit(1)
+	}
 
 	// サーバー起動
-	c := registry.NewController()
-	m := middleware.NewMiddleware()
+	metrics := registry.NewMetrics()
+	c, cleanup, err := registry.NewController(cfg, logHandler, metrics)
+	if err != nil {
+		slog.Error(fmt.Sprintf("コントローラーの設定に失敗しました。: %s", err.Error()))
+		shutdownTracing(context.Background())
+		os.Exit(1)
+	}
+	m, err := registry.NewMiddleware(cfg, logHandler, metrics, c.Authorizer)
+	if err != nil {
+		slog.Error(fmt.Sprintf("ミドルウェアの設定に失敗しました。: %s", err.Error()))
+		cleanup()
+		shutdownTracing(context.Background())
+		os.Exit(1)
+	}
 	r := router.SetupRouter(c, m)
-	r.Run(startPort)
+	admin := router.SetupAdminRouter(metrics.Handler())
+	err = runServer(r, admin, cfg.Server, c.Health.SetShuttingDown)
+
+	// DB接続などのリソースを解放
+	if cleanupErr := cleanup(); cleanupErr != nil {
+		slog.Error(fmt.Sprintf("リソースの解放に失敗しました。: %s", cleanupErr.Error()))
+	}
+
+	// 未送信のスパンを送信（送信先に接続できない場合もタイムアウトで打ち切る）
+	tracingCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	if tracingErr := shutdownTracing(tracingCtx); tracingErr != nil {
+		slog.Error(fmt.Sprintf("トレースの終了処理に失敗しました。: %s", tracingErr.Error()))
+	}
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("サーバーの実行に失敗しました。: %s", err.Error()))
+		os.Exit(1)
+	}
+	slog.Info("Gin Server stopped")
 }
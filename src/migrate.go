@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"go-gin-domain/internal/config"
+	"go-gin-domain/internal/infrastructure/database"
+	"go-gin-domain/internal/registry"
+)
+
+const migrateUsage = `使い方:
+  go run . migrate up             未適用のマイグレーションを全て適用
+  go run . migrate down [N]       適用済みのマイグレーションを新しい順にN件（デフォルト1件）ロールバック
+  go run . migrate status         マイグレーションの適用状況を表示
+  go run . migrate create <name>  マイグレーションファイル（up/down）を作成`
+
+// マイグレーション用のサブコマンド
+func runMigrate(cfg *config.Config, logHandler slog.Handler, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("サブコマンドを指定して下さい。\n%s", migrateUsage)
+	}
+
+	// ファイル作成のみDB接続が不要
+	if args[0] == "create" {
+		if len(args) != 2 {
+			return fmt.Errorf("マイグレーション名を指定して下さい。\n%s", migrateUsage)
+		}
+
+		paths, err := database.CreateMigrationFiles(cfg.Migrations.Dir, args[1])
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			fmt.Printf("Created %s\n", path)
+		}
+		return nil
+	}
+
+	// DB接続前に引数をチェック
+	n := 1
+	switch args[0] {
+	case "up", "status":
+	case "down":
+		if len(args) > 1 {
+			var err error
+			n, err = strconv.Atoi(args[1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("ロールバックする件数は1以上の数値を指定して下さい。: %s", args[1])
+			}
+		}
+	default:
+		return fmt.Errorf("不明なサブコマンドです。: %s\n%s", args[0], migrateUsage)
+	}
+
+	migrator, closeDB, err := registry.NewMigrator(cfg, logHandler)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		count, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Applied %d migration(s)\n", count)
+	case "down":
+		count, err := migrator.Down(ctx, n)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Rolled back %d migration(s)\n", count)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+		for _, status := range statuses {
+			state, appliedAt := "pending", "-"
+			if status.AppliedAt != nil {
+				state = "applied"
+				appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			if status.Missing {
+				state = "applied (file missing)"
+			}
+			fmt.Fprintf(w, "%06d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+		}
+		return w.Flush()
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go-gin-domain/internal/config"
+)
+
+// HTTPサーバーを起動し、SIGINTまたはSIGTERMを受信したら処理中のリクエストの完了を待って停止する
+// （シグナルの受信時にonShutdownを呼び出す）
+// adminHandlerは管理用ポート（メトリクスなど）で公開するハンドラー（管理用ポートが未設定の場合は起動しない）
+func runServer(handler, adminHandler http.Handler, cfg config.ServerConfig, onShutdown func()) error {
+	servers := []*http.Server{newHTTPServer(cfg.Addr(), handler, cfg)}
+	if cfg.AdminPort != "" {
+		servers = append(servers, newHTTPServer(cfg.AdminAddr(), adminHandler, cfg))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// 2回目のシグナルは通常どおり即時終了させる
+	context.AfterFunc(ctx, stop)
+
+	return serve(ctx, servers, cfg, onShutdown)
+}
+
+// サーバーを起動し、ctxがキャンセルされたら処理中のリクエストの完了を待って停止する
+func serve(ctx context.Context, servers []*http.Server, cfg config.ServerConfig, onShutdown func()) error {
+	errCh := make(chan error, len(servers))
+	for _, srv := range servers {
+		go func() {
+			errCh <- srv.ListenAndServe()
+		}()
+	}
+
+	var startErr error
+	select {
+	case startErr = <-errCh:
+		// 起動に失敗した場合（ポートが使用中など）は、起動済みのサーバーも停止する
+	case <-ctx.Done():
+		// レディネスを失敗させ、ロードバランサーが振り分け対象から外すまで新規リクエストを受け付ける
+		onShutdown()
+		if cfg.ShutdownDelay > 0 {
+			slog.Info(fmt.Sprintf("Waiting %s before shutting down Gin Server", cfg.ShutdownDelay))
+			time.Sleep(cfg.ShutdownDelay)
+		}
+	}
+
+	slog.Info(fmt.Sprintf("Shutting down Gin Server (timeout: %s)", cfg.ShutdownTimeout))
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	errs := []error{startErr}
+	for _, srv := range servers {
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			errs = append(errs, fmt.Errorf("サーバーの停止に失敗しました。: %w", err))
+		}
+	}
+
+	// 停止したサーバーの終了結果を確認する（起動に失敗したサーバーの分は受信済み）
+	remaining := len(servers)
+	if startErr != nil {
+		remaining--
+	}
+	for range remaining {
+		if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func newHTTPServer(addr string, handler http.Handler, cfg config.ServerConfig) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		ReadTimeout:       cfg.ReadTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+		MaxHeaderBytes:    cfg.MaxHeaderBytes,
+	}
+}
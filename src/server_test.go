@@ -0,0 +1,164 @@
+//go:build unit
+
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go-gin-domain/internal/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 空いているポートのアドレスを取得する
+func freeAddr(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+// サーバーが起動するまで待つ
+func waitForServer(t *testing.T, addr string) {
+	t.Helper()
+	for range 100 {
+		if conn, err := net.Dial("tcp", addr); err == nil {
+			conn.Close()
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("サーバーが起動しませんでした。: %s", addr)
+}
+
+func TestServe(t *testing.T) {
+	t.Run("キャンセル時に停止処理を呼び出し、処理中のリクエストの完了を待って両方のサーバーを停止すること", func(t *testing.T) {
+		// 処理中のリクエスト（停止の開始後に完了する）
+		started := make(chan struct{})
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			time.Sleep(200 * time.Millisecond)
+			w.WriteHeader(http.StatusOK)
+		})
+		addr, adminAddr := freeAddr(t), freeAddr(t)
+		servers := []*http.Server{
+			{Addr: addr, Handler: handler},
+			{Addr: adminAddr, Handler: http.NotFoundHandler()},
+		}
+		cfg := config.ServerConfig{ShutdownTimeout: 5 * time.Second}
+
+		var shuttingDown atomic.Bool
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() {
+			done <- serve(ctx, servers, cfg, func() { shuttingDown.Store(true) })
+		}()
+		waitForServer(t, addr)
+		waitForServer(t, adminAddr)
+
+		status := make(chan int, 1)
+		go func() {
+			res, err := http.Get("http://" + addr)
+			if err != nil {
+				status <- 0
+				return
+			}
+			res.Body.Close()
+			status <- res.StatusCode
+		}()
+		<-started
+
+		// テストの実行
+		cancel()
+
+		// 検証
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(cfg.ShutdownTimeout):
+			t.Fatal("サーバーが停止しませんでした。")
+		}
+		assert.True(t, shuttingDown.Load())
+		assert.Equal(t, http.StatusOK, <-status)
+		for _, a := range []string{addr, adminAddr} {
+			_, err := net.Dial("tcp", a)
+			assert.Error(t, err, a)
+		}
+	})
+
+	t.Run("処理中のリクエストが停止のタイムアウトまでに完了しない場合にエラーを返すこと", func(t *testing.T) {
+		// 処理中のリクエスト（テストの終了まで完了しない）
+		started := make(chan struct{})
+		release := make(chan struct{})
+		defer close(release)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+		})
+		addr := freeAddr(t)
+		servers := []*http.Server{{Addr: addr, Handler: handler}}
+		cfg := config.ServerConfig{ShutdownTimeout: 100 * time.Millisecond}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() {
+			done <- serve(ctx, servers, cfg, func() {})
+		}()
+		waitForServer(t, addr)
+
+		go func() {
+			if res, err := http.Get("http://" + addr); err == nil {
+				res.Body.Close()
+			}
+		}()
+		<-started
+
+		// テストの実行
+		cancel()
+
+		// 検証
+		select {
+		case err := <-done:
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		case <-time.After(5 * time.Second):
+			t.Fatal("サーバーが停止しませんでした。")
+		}
+	})
+
+	t.Run("起動に失敗した場合に起動済みのサーバーも停止してエラーを返すこと", func(t *testing.T) {
+		// 使用中のポート
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer l.Close()
+
+		addr := freeAddr(t)
+		servers := []*http.Server{
+			{Addr: addr, Handler: http.NotFoundHandler()},
+			{Addr: l.Addr().String(), Handler: http.NotFoundHandler()},
+		}
+		cfg := config.ServerConfig{ShutdownTimeout: 5 * time.Second}
+
+		var shuttingDown atomic.Bool
+
+		// テストの実行
+		err = serve(context.Background(), servers, cfg, func() { shuttingDown.Store(true) })
+
+		// 検証
+		var opErr *net.OpError
+		assert.True(t, errors.As(err, &opErr))
+		assert.False(t, shuttingDown.Load())
+		_, err = net.Dial("tcp", addr)
+		assert.Error(t, err)
+	})
+}